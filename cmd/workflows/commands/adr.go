@@ -19,6 +19,7 @@ func NewADRCommand() *ADRCommand {
 	}
 	
 	// Register subcommands
+	cmd.Register(NewADRListCommand())
 	cmd.Register(NewADRNewCommand())
 	cmd.Register(NewADRRenderCommand())
 	cmd.Register(NewADRValidateCommand())
@@ -34,6 +35,7 @@ func (c *ADRCommand) Usage() {
 	// Add examples
 	println()
 	println("Examples:")
+	println("  workflows adr list --status accepted --since 2024-01-01")
 	println("  workflows adr new --title \"Use PostgreSQL\" --status proposed")
 	println("  workflows adr render my-adr.json")
 	println("  workflows adr validate my-adr.json")
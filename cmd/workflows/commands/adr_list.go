@@ -0,0 +1,124 @@
+package commands
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/mattbarlow-sg/workflows/internal/adr"
+	"github.com/mattbarlow-sg/workflows/internal/cli"
+	"github.com/mattbarlow-sg/workflows/internal/errors"
+)
+
+// ADRListCommand implements the ADR list subcommand
+type ADRListCommand struct {
+	*cli.BaseCommand
+	status string
+	tag    string
+	author string
+	since  string
+	until  string
+}
+
+// NewADRListCommand creates a new ADR list command
+func NewADRListCommand() *ADRListCommand {
+	cmd := &ADRListCommand{
+		BaseCommand: cli.NewBaseCommand(
+			"list",
+			"List ADRs filtered by status, tag, author or date",
+		),
+	}
+
+	// Define flags
+	cmd.FlagSet().StringVar(&cmd.status, "status", "", "Only list ADRs with this status")
+	cmd.FlagSet().StringVar(&cmd.tag, "tag", "", "Only list ADRs with this tag")
+	cmd.FlagSet().StringVar(&cmd.author, "author", "", "Only list ADRs with this decider")
+	cmd.FlagSet().StringVar(&cmd.since, "since", "", "Only list ADRs decided on or after this date (YYYY-MM-DD)")
+	cmd.FlagSet().StringVar(&cmd.until, "until", "", "Only list ADRs decided on or before this date (YYYY-MM-DD)")
+
+	return cmd
+}
+
+// Execute runs the ADR list command
+func (c *ADRListCommand) Execute(args []string) error {
+	// Parse flags
+	if err := c.ParseFlags(args); err != nil {
+		return errors.NewUsageError(fmt.Sprintf("invalid arguments: %v", err))
+	}
+
+	dir := "docs/adr"
+	if c.NArg() > 0 {
+		dir = c.Arg(0)
+	}
+
+	filter := adr.ADRFilter{
+		Status: c.status,
+		Tag:    c.tag,
+		Author: c.author,
+	}
+
+	var err error
+	if filter.Since, err = parseADRDate(c.since, "since"); err != nil {
+		return err
+	}
+	if filter.Until, err = parseADRDate(c.until, "until"); err != nil {
+		return err
+	}
+
+	store := adr.NewADRStore(dir)
+	if err := store.Load(); err != nil {
+		return errors.NewIOError("loading ADRs", err)
+	}
+
+	adrs := store.Query(filter)
+	if len(adrs) == 0 {
+		fmt.Println("No matching ADRs found in", dir)
+		return nil
+	}
+
+	// Display ADRs in table format
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "ID\tDATE\tSTATUS\tTITLE")
+	fmt.Fprintln(w, "--\t----\t------\t-----")
+
+	for _, a := range adrs {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", a.ID, a.Date, a.Status, a.Title)
+	}
+
+	w.Flush()
+	return nil
+}
+
+// parseADRDate parses an optional YYYY-MM-DD flag value
+func parseADRDate(value, flagName string) (time.Time, error) {
+	if value == "" {
+		return time.Time{}, nil
+	}
+	t, err := time.Parse(adr.DateFormat, strings.TrimSpace(value))
+	if err != nil {
+		return time.Time{}, errors.NewUsageError(fmt.Sprintf("invalid -%s date '%s': expected YYYY-MM-DD", flagName, value))
+	}
+	return t, nil
+}
+
+// Usage prints detailed usage for the ADR list command
+func (c *ADRListCommand) Usage() {
+	fmt.Println("List ADRs in a directory, sorted by date")
+	fmt.Println()
+	fmt.Println("Usage:")
+	fmt.Println("  workflows adr list [flags] [adr-directory]")
+	fmt.Println()
+	fmt.Println("The directory defaults to docs/adr.")
+	fmt.Println()
+	fmt.Println("Flags:")
+	c.FlagSet().PrintDefaults()
+	fmt.Println()
+	fmt.Println("Examples:")
+	fmt.Println("  # Accepted decisions made this year")
+	fmt.Println("  workflows adr list -status accepted -since 2024-01-01")
+	fmt.Println()
+	fmt.Println("  # Decisions tagged 'database' by a given decider")
+	fmt.Println("  workflows adr list -tag database -author cto docs/adr")
+}
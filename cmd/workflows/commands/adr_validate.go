@@ -2,7 +2,10 @@ package commands
 
 import (
 	"fmt"
+	"os"
+	"path/filepath"
 
+	"github.com/mattbarlow-sg/workflows/internal/adr"
 	"github.com/mattbarlow-sg/workflows/internal/cli"
 	"github.com/mattbarlow-sg/workflows/internal/config"
 	"github.com/mattbarlow-sg/workflows/internal/errors"
@@ -39,6 +42,11 @@ func (c *ADRValidateCommand) Execute(args []string) error {
 	
 	filePath := c.Arg(0)
 	
+	// A directory validates every ADR in it plus the links between them
+	if info, err := os.Stat(filePath); err == nil && info.IsDir() {
+		return c.validateDirectory(filePath)
+	}
+	
 	// Validate inputs
 	if err := cli.NewValidationChain().
 		ValidateFilePath(filePath, "file path").
@@ -71,18 +79,78 @@ func (c *ADRValidateCommand) Execute(args []string) error {
 	return errors.NewValidationError("ADR validation failed", nil)
 }
 
+// validateDirectory validates each ADR file in dir and the supersession links between them
+func (c *ADRValidateCommand) validateDirectory(dir string) error {
+	if err := cli.NewValidationChain().
+		ValidateFilePath(dir, "directory path").
+		Error(); err != nil {
+		return err
+	}
+	
+	cfg := config.New()
+	schemaPath := cfg.GetSchemaPath("adr")
+	
+	files, err := filepath.Glob(filepath.Join(dir, "*.json"))
+	if err != nil {
+		return errors.NewIOError("listing ADR files", err)
+	}
+	
+	invalid := 0
+	for _, file := range files {
+		result, err := schema.ValidateFile(schemaPath, file)
+		if err != nil {
+			return errors.NewIOError("validating file", err)
+		}
+		if result.Valid {
+			fmt.Printf("✓ ADR file '%s' is valid\n", file)
+			continue
+		}
+		invalid++
+		fmt.Printf("✗ ADR file '%s' is invalid\n", file)
+		for i, err := range result.Errors {
+			fmt.Printf("  %d. %s\n", i+1, err)
+		}
+	}
+	
+	// Check supersession links across the collection
+	store := adr.NewADRStore(dir)
+	if err := store.Load(); err != nil {
+		return errors.NewIOError("loading ADRs", err)
+	}
+	
+	linkErrors := store.ValidateSupersessions()
+	if len(linkErrors) == 0 {
+		fmt.Println("✓ Supersession links are consistent")
+	} else {
+		fmt.Println("\nSupersession errors:")
+		for i, err := range linkErrors {
+			fmt.Printf("  %d. [%s] %s\n", i+1, err.Type, err.Error())
+		}
+	}
+	
+	if invalid > 0 || len(linkErrors) > 0 {
+		return errors.NewValidationError("ADR validation failed", nil)
+	}
+	return nil
+}
+
 // Usage prints detailed usage for the ADR validate command
 func (c *ADRValidateCommand) Usage() {
 	fmt.Println("Validate an ADR file against the JSON schema")
 	fmt.Println()
 	fmt.Println("Usage:")
 	fmt.Println("  workflows adr validate <adr-file.json>")
+	fmt.Println("  workflows adr validate <adr-directory>")
 	fmt.Println()
 	fmt.Println("Examples:")
 	fmt.Println("  workflows adr validate my-adr.json")
+	fmt.Println("  workflows adr validate docs/adr")
 	fmt.Println()
 	fmt.Println("The validator will check:")
 	fmt.Println("  - All required fields are present")
 	fmt.Println("  - Field values meet constraints (length, format, enum values)")
 	fmt.Println("  - JSON structure matches the schema")
+	fmt.Println()
+	fmt.Println("When given a directory, every ADR in it is validated and supersession")
+	fmt.Println("links (supersedes / superseded-by) are checked for unknown ADR IDs and cycles.")
 }
\ No newline at end of file
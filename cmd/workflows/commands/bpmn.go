@@ -22,6 +22,8 @@ func NewBPMNCommand() *BPMNCommand {
 	cmd.Register(NewBPMNValidateCommand())
 	cmd.Register(NewBPMNAnalyzeCommand())
 	cmd.Register(NewBPMNRenderCommand())
+	cmd.Register(NewBPMNDiffCommand())
+	cmd.Register(NewBPMNSimulateCommand())
 	
 	return cmd
 }
@@ -37,4 +39,6 @@ func (c *BPMNCommand) Usage() {
 	println("  workflows bpmn validate process.json")
 	println("  workflows bpmn analyze workflow.json")
 	println("  workflows bpmn render -format dot process.json")
+	println("  workflows bpmn diff process-v1.json process-v2.json")
+	println("  workflows bpmn simulate -decision gateway=flow process.json")
 }
\ No newline at end of file
@@ -1,6 +1,7 @@
 package commands
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
 
@@ -12,16 +13,31 @@ import (
 // BPMNAnalyzeCommand implements the BPMN analyze subcommand
 type BPMNAnalyzeCommand struct {
 	*cli.BaseCommand
+	thresholds    bpmn.MetricThresholds
+	format        string
+	strict        bool
+	requireAgents bool
 }
 
 // NewBPMNAnalyzeCommand creates a new BPMN analyze command
 func NewBPMNAnalyzeCommand() *BPMNAnalyzeCommand {
-	return &BPMNAnalyzeCommand{
+	cmd := &BPMNAnalyzeCommand{
 		BaseCommand: cli.NewBaseCommand(
 			"analyze",
 			"Analyze a BPMN process",
 		),
 	}
+	
+	// Define flags
+	cmd.FlagSet().IntVar(&cmd.thresholds.MaxComplexity, "max-complexity", 0, "Fail if complexity exceeds this value (0 disables)")
+	cmd.FlagSet().IntVar(&cmd.thresholds.MaxDepth, "max-depth", 0, "Fail if process depth exceeds this value (0 disables)")
+	cmd.FlagSet().IntVar(&cmd.thresholds.MaxWidth, "max-width", 0, "Fail if process width exceeds this value (0 disables)")
+	cmd.FlagSet().Float64Var(&cmd.thresholds.MaxConnectivity, "max-connectivity", 0, "Fail if connectivity exceeds this value (0 disables)")
+	cmd.FlagSet().StringVar(&cmd.format, "format", "text", "Output format: text, json")
+	cmd.FlagSet().BoolVar(&cmd.strict, "strict", false, "Fail if deadlocks, unreachable elements, lint errors or message flow issues are found")
+	cmd.FlagSet().BoolVar(&cmd.requireAgents, "require-agents", false, "Report user and manual tasks without an assigned agent as lint errors")
+	
+	return cmd
 }
 
 // Execute runs the BPMN analyze command
@@ -37,18 +53,32 @@ func (c *BPMNAnalyzeCommand) Execute(args []string) error {
 		return errors.NewUsageError("analyze command requires file path")
 	}
 	
-	filePath := c.Arg(0)
-	
 	// Validate inputs
-	if err := cli.NewValidationChain().
-		ValidateFilePath(filePath, "file path").
-		ValidateFileExtension(filePath, []string{".json"}, "file type").
-		Error(); err != nil {
-		return err
+	for _, filePath := range c.Args() {
+		if err := cli.NewValidationChain().
+			ValidateFilePath(filePath, "file path").
+			ValidateFileExtension(filePath, []string{".json"}, "file type").
+			Error(); err != nil {
+			return err
+		}
+	}
+	
+	if c.format != "text" && c.format != "json" {
+		return errors.NewValidationError(fmt.Sprintf("invalid format '%s', must be one of: [text json]", c.format), nil)
 	}
 	
+	// Multiple files are analyzed together to trace call activities
+	if c.NArg() > 1 {
+		return c.analyzeMultiple(c.Args())
+	}
+	
+	filePath := c.Arg(0)
+	
 	// Create analyzer
-	analyzer := &bpmn.FileAnalyzer{}
+	analyzer := &bpmn.FileAnalyzer{RequireHumanAgents: c.requireAgents}
+	if !c.thresholds.IsZero() {
+		analyzer.Thresholds = &c.thresholds
+	}
 	
 	// Analyze the file
 	result, err := analyzer.AnalyzeFile(filePath)
@@ -57,6 +87,33 @@ func (c *BPMNAnalyzeCommand) Execute(args []string) error {
 	}
 	
 	// Display analysis results
+	if c.format == "json" {
+		data, err := json.MarshalIndent(result, "", "  ")
+		if err != nil {
+			return errors.NewIOError("encoding analysis", err)
+		}
+		fmt.Println(string(data))
+	} else {
+		c.printReport(filePath, result)
+	}
+	
+	// Threshold violations
+	if len(result.Violations) > 0 {
+		return errors.NewValidationError(fmt.Sprintf("%d process metric(s) exceed thresholds", len(result.Violations)), nil)
+	}
+	
+	// Strict mode
+	if c.strict {
+		if issues := result.StrictIssues(); len(issues) > 0 {
+			return errors.NewValidationError(fmt.Sprintf("strict analysis failed: %s", strings.Join(issues, "; ")), nil)
+		}
+	}
+	
+	return nil
+}
+
+// printReport prints the text analysis report for a single process
+func (c *BPMNAnalyzeCommand) printReport(filePath string, result *bpmn.AnalysisResult) {
 	fmt.Printf("BPMN Process Analysis for: %s\n", filePath)
 	fmt.Println(strings.Repeat("=", 50))
 	
@@ -101,6 +158,13 @@ func (c *BPMNAnalyzeCommand) Execute(args []string) error {
 		}
 	}
 	
+	if len(result.DanglingFlows) > 0 {
+		fmt.Printf("\nDangling Flows:\n")
+		for _, flow := range result.DanglingFlows {
+			fmt.Printf("  - %s\n", flow)
+		}
+	}
+	
 	// Deadlocks
 	if len(result.Deadlocks) > 0 {
 		fmt.Printf("\nPotential Deadlocks:\n")
@@ -112,8 +176,8 @@ func (c *BPMNAnalyzeCommand) Execute(args []string) error {
 	// Agent workload
 	if len(result.AgentWorkload.AgentTasks) > 0 {
 		fmt.Printf("\nAgent Workload:\n")
-		for agent, tasks := range result.AgentWorkload.AgentTasks {
-			fmt.Printf("  %s: %d tasks\n", agent, len(tasks))
+		for _, agent := range result.AgentWorkload.SortedAgents() {
+			fmt.Printf("  %s: %d tasks\n", agent, len(result.AgentWorkload.AgentTasks[agent]))
 		}
 		if len(result.AgentWorkload.UnassignedTasks) > 0 {
 			fmt.Printf("  Unassigned: %d tasks\n", len(result.AgentWorkload.UnassignedTasks))
@@ -124,6 +188,77 @@ func (c *BPMNAnalyzeCommand) Execute(args []string) error {
 		}
 	}
 	
+	// Lint warnings
+	if len(result.LintWarnings) > 0 {
+		fmt.Printf("\nLint Warnings:\n")
+		for _, w := range result.LintWarnings {
+			severity := ""
+			if w.IsError() {
+				severity = " (error)"
+			}
+			message := w.Message
+			if w.ElementName != "" {
+				message = fmt.Sprintf("%s [%s]", message, w.ElementName)
+			}
+			fmt.Printf("  - %s%s: %s\n", w.Type, severity, message)
+		}
+	}
+	
+	// Message flows
+	if len(result.MessageFlowIssues) > 0 {
+		fmt.Printf("\nMessage Flow Issues:\n")
+		for _, issue := range result.MessageFlowIssues {
+			fmt.Printf("  - %s: %s\n", issue.Type, issue.Message)
+		}
+	}
+	
+	// Threshold violations
+	if len(result.Violations) > 0 {
+		fmt.Printf("\nThreshold Violations:\n")
+		for _, v := range result.Violations {
+			fmt.Printf("  - %s: %g (max %g)\n", v.Metric, v.Value, v.Threshold)
+		}
+	}
+}
+
+// analyzeMultiple analyzes several processes and the call links between them
+func (c *BPMNAnalyzeCommand) analyzeMultiple(filePaths []string) error {
+	analyzer := &bpmn.FileAnalyzer{RequireHumanAgents: c.requireAgents}
+	if !c.thresholds.IsZero() {
+		analyzer.Thresholds = &c.thresholds
+	}
+	
+	result, err := analyzer.AnalyzeFiles(filePaths)
+	if err != nil {
+		return errors.NewIOError("analyzing BPMN files", err)
+	}
+	
+	if c.format == "json" {
+		data, err := json.MarshalIndent(result, "", "  ")
+		if err != nil {
+			return errors.NewIOError("encoding analysis", err)
+		}
+		fmt.Println(string(data))
+	} else {
+		fmt.Print(bpmn.FormatMultiProcessReport(result))
+	}
+	
+	if len(result.Cycles) > 0 {
+		return errors.NewValidationError(fmt.Sprintf("%d cross-process call cycle(s) detected", len(result.Cycles)), nil)
+	}
+	
+	// Threshold violations in any process
+	if violations := result.ThresholdViolations(); len(violations) > 0 {
+		return errors.NewValidationError(fmt.Sprintf("%d process metric(s) exceed thresholds", len(violations)), nil)
+	}
+	
+	// Strict mode
+	if c.strict {
+		if issues := result.StrictIssues(); len(issues) > 0 {
+			return errors.NewValidationError(fmt.Sprintf("strict analysis failed: %s", strings.Join(issues, "; ")), nil)
+		}
+	}
+	
 	return nil
 }
 
@@ -132,15 +267,39 @@ func (c *BPMNAnalyzeCommand) Usage() {
 	fmt.Println("Analyze a BPMN process")
 	fmt.Println()
 	fmt.Println("Usage:")
-	fmt.Println("  workflows bpmn analyze <file>")
+	fmt.Println("  workflows bpmn analyze [flags] <file>")
+	fmt.Println("  workflows bpmn analyze [flags] <file> <file>...")
+	fmt.Println()
+	fmt.Println("Flags:")
+	c.FlagSet().PrintDefaults()
 	fmt.Println()
 	fmt.Println("The analyzer provides:")
 	fmt.Println("  - Process metrics and complexity analysis")
 	fmt.Println("  - Element counts and breakdown")
 	fmt.Println("  - Agent workload distribution")
 	fmt.Println("  - Potential issues and recommendations")
+	fmt.Println("  - Lint warnings for likely modeling mistakes")
+	fmt.Println()
+	fmt.Println("When the file declares a collaboration, its message flows are checked;")
+	fmt.Println("flows to elements of pools defined in other files are reported as")
+	fmt.Println("unknown unless those files are analyzed together.")
+	fmt.Println()
+	fmt.Println("With -strict, the command exits non-zero when potential deadlocks,")
+	fmt.Println("elements unreachable from a start event, lint errors or message flow")
+	fmt.Println("issues are found.")
+	fmt.Println("-require-agents makes user and manual tasks without an assigned agent")
+	fmt.Println("lint errors; automated tasks such as service tasks are not checked.")
+	fmt.Println()
+	fmt.Println("When several files are given, call activities are resolved to the")
+	fmt.Println("processes they invoke (by calledElement) and the set is checked for")
+	fmt.Println("unreachable processes, call cycles and unresolved call targets.")
+	fmt.Println("Thresholds, -strict and -require-agents apply to each process.")
 	fmt.Println()
 	fmt.Println("Examples:")
 	fmt.Println("  workflows bpmn analyze process.json")
 	fmt.Println("  workflows bpmn analyze complex-workflow.json")
+	fmt.Println("  workflows bpmn analyze -max-complexity 50 -max-depth 10 process.json")
+	fmt.Println("  workflows bpmn analyze -format json -strict process.json")
+	fmt.Println("  workflows bpmn analyze -strict -require-agents process.json")
+	fmt.Println("  workflows bpmn analyze order.json payment.json shipping.json")
 }
\ No newline at end of file
@@ -0,0 +1,108 @@
+package commands
+
+import (
+	"encoding/json"
+	"io"
+	"os"
+	"strings"
+	"testing"
+)
+
+// analyzeFixture has a flow to an undeclared element and a user task with
+// no agent, which -require-agents reports as a lint error
+const analyzeFixture = `{
+  "process": {
+    "id": "review_process",
+    "name": "Review Process",
+    "elements": {
+      "events": [
+        {"id": "start", "name": "Start", "type": "startEvent", "outgoing": ["flow1"]},
+        {"id": "end", "name": "End", "type": "endEvent", "incoming": ["flow2"]}
+      ],
+      "activities": [
+        {"id": "task_review", "name": "Review", "type": "userTask", "incoming": ["flow1"], "outgoing": ["flow2", "flow3"]}
+      ],
+      "sequenceFlows": [
+        {"id": "flow1", "sourceRef": "start", "targetRef": "task_review"},
+        {"id": "flow2", "sourceRef": "task_review", "targetRef": "end"},
+        {"id": "flow3", "sourceRef": "task_review", "targetRef": "data_object"}
+      ]
+    }
+  }
+}`
+
+func writeAnalyzeFixture(t *testing.T) string {
+	t.Helper()
+	// File paths must be relative to the working directory
+	t.Chdir(t.TempDir())
+	if err := os.WriteFile("process.json", []byte(analyzeFixture), 0644); err != nil {
+		t.Fatal(err)
+	}
+	return "process.json"
+}
+
+// captureStdout returns what fn prints to stdout
+func captureStdout(t *testing.T, fn func()) string {
+	t.Helper()
+	r, w, err := os.Pipe()
+	if err != nil {
+		t.Fatal(err)
+	}
+	stdout := os.Stdout
+	os.Stdout = w
+	defer func() { os.Stdout = stdout }()
+
+	done := make(chan []byte)
+	go func() {
+		data, _ := io.ReadAll(r)
+		done <- data
+	}()
+	fn()
+	w.Close()
+	return string(<-done)
+}
+
+func TestBPMNAnalyzeStrict(t *testing.T) {
+	path := writeAnalyzeFixture(t)
+
+	var err error
+	captureStdout(t, func() {
+		err = NewBPMNAnalyzeCommand().Execute([]string{"-require-agents", path})
+	})
+	if err != nil {
+		t.Errorf("Without -strict, analyze should succeed, got %v", err)
+	}
+
+	captureStdout(t, func() {
+		err = NewBPMNAnalyzeCommand().Execute([]string{"-strict", "-require-agents", path})
+	})
+	if err == nil || !strings.Contains(err.Error(), "strict analysis failed") {
+		t.Errorf("With -strict, analyze should fail on lint errors, got %v", err)
+	}
+}
+
+func TestBPMNAnalyzeJSON(t *testing.T) {
+	path := writeAnalyzeFixture(t)
+
+	var err error
+	out := captureStdout(t, func() {
+		err = NewBPMNAnalyzeCommand().Execute([]string{"-format", "json", "-strict", "-require-agents", path})
+	})
+	if err == nil || !strings.Contains(err.Error(), "strict analysis failed") {
+		t.Errorf("With -strict, analyze should fail in JSON mode too, got %v", err)
+	}
+
+	var result struct {
+		DanglingFlows []string `json:"dangling_flows"`
+		LintWarnings  []any    `json:"lint_warnings"`
+	}
+	if err := json.Unmarshal([]byte(out), &result); err != nil {
+		t.Fatalf("Output is not valid JSON: %v\n%s", err, out)
+	}
+	if len(result.DanglingFlows) != 1 || result.DanglingFlows[0] != "flow3" {
+		t.Errorf("Should report flow3 as dangling, got %v", result.DanglingFlows)
+	}
+	if len(result.LintWarnings) == 0 {
+		t.Error("Should report the unassigned user task")
+	}
+}
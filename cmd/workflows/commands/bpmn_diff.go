@@ -0,0 +1,97 @@
+package commands
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/mattbarlow-sg/workflows/internal/bpmn"
+	"github.com/mattbarlow-sg/workflows/internal/cli"
+	"github.com/mattbarlow-sg/workflows/internal/errors"
+)
+
+// BPMNDiffCommand implements the BPMN diff subcommand
+type BPMNDiffCommand struct {
+	*cli.BaseCommand
+	format string
+}
+
+// NewBPMNDiffCommand creates a new BPMN diff command
+func NewBPMNDiffCommand() *BPMNDiffCommand {
+	cmd := &BPMNDiffCommand{
+		BaseCommand: cli.NewBaseCommand(
+			"diff",
+			"Show changes between two versions of a BPMN process",
+		),
+	}
+
+	// Define flags
+	cmd.FlagSet().StringVar(&cmd.format, "format", "text", "Output format: text, json")
+
+	return cmd
+}
+
+// Execute runs the BPMN diff command
+func (c *BPMNDiffCommand) Execute(args []string) error {
+	// Parse flags
+	if err := c.ParseFlags(args); err != nil {
+		return errors.NewUsageError(fmt.Sprintf("invalid arguments: %v", err))
+	}
+
+	// Check required arguments
+	if c.NArg() != 2 {
+		c.Usage()
+		return errors.NewUsageError("diff command requires old and new file paths")
+	}
+
+	oldPath, newPath := c.Arg(0), c.Arg(1)
+
+	// Validate inputs
+	if err := cli.NewValidationChain().
+		ValidateFilePath(oldPath, "old file path").
+		ValidateFileExtension(oldPath, []string{".json"}, "old file type").
+		ValidateFilePath(newPath, "new file path").
+		ValidateFileExtension(newPath, []string{".json"}, "new file type").
+		Error(); err != nil {
+		return err
+	}
+
+	if c.format != "text" && c.format != "json" {
+		return errors.NewValidationError(fmt.Sprintf("invalid format '%s', must be one of: [text json]", c.format), nil)
+	}
+
+	differ := &bpmn.FileDiffer{}
+	diff, err := differ.DiffFiles(oldPath, newPath)
+	if err != nil {
+		return errors.NewIOError("comparing BPMN files", err)
+	}
+
+	if c.format == "json" {
+		data, err := json.MarshalIndent(diff, "", "  ")
+		if err != nil {
+			return errors.NewIOError("encoding diff", err)
+		}
+		fmt.Println(string(data))
+		return nil
+	}
+
+	fmt.Print(bpmn.FormatProcessDiff(diff))
+	return nil
+}
+
+// Usage prints detailed usage for the BPMN diff command
+func (c *BPMNDiffCommand) Usage() {
+	fmt.Println("Show changes between two versions of a BPMN process")
+	fmt.Println()
+	fmt.Println("Usage:")
+	fmt.Println("  workflows bpmn diff [flags] <old-file> <new-file>")
+	fmt.Println()
+	fmt.Println("Flags:")
+	c.FlagSet().PrintDefaults()
+	fmt.Println()
+	fmt.Println("Elements are matched by ID. An ID reused with a different type is")
+	fmt.Println("reported as a removal and an addition.")
+	fmt.Println()
+	fmt.Println("Examples:")
+	fmt.Println("  workflows bpmn diff process-v1.json process-v2.json")
+	fmt.Println("  workflows bpmn diff -format json process-v1.json process-v2.json")
+}
@@ -0,0 +1,132 @@
+package commands
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/mattbarlow-sg/workflows/internal/bpmn"
+	"github.com/mattbarlow-sg/workflows/internal/cli"
+	"github.com/mattbarlow-sg/workflows/internal/errors"
+)
+
+// gatewayDecisions collects repeated -decision gateway=flow flags
+type gatewayDecisions map[string]string
+
+func (d gatewayDecisions) String() string {
+	var pairs []string
+	for gateway, flow := range d {
+		pairs = append(pairs, gateway+"="+flow)
+	}
+	return strings.Join(pairs, ",")
+}
+
+func (d gatewayDecisions) Set(value string) error {
+	gateway, flow, ok := strings.Cut(value, "=")
+	if !ok || gateway == "" || flow == "" {
+		return fmt.Errorf("decision must be gateway=flow, got %q", value)
+	}
+	d[gateway] = flow
+	return nil
+}
+
+// BPMNSimulateCommand implements the BPMN simulate subcommand
+type BPMNSimulateCommand struct {
+	*cli.BaseCommand
+	decisions gatewayDecisions
+	format    string
+}
+
+// NewBPMNSimulateCommand creates a new BPMN simulate command
+func NewBPMNSimulateCommand() *BPMNSimulateCommand {
+	cmd := &BPMNSimulateCommand{
+		BaseCommand: cli.NewBaseCommand(
+			"simulate",
+			"Play tokens through a BPMN process",
+		),
+		decisions: gatewayDecisions{},
+	}
+
+	// Define flags
+	cmd.FlagSet().Var(cmd.decisions, "decision", "Flow a gateway or activity takes, as element=flow (flow ID or target element ID); repeatable")
+	cmd.FlagSet().StringVar(&cmd.format, "format", "text", "Output format: text, json")
+
+	return cmd
+}
+
+// Execute runs the BPMN simulate command
+func (c *BPMNSimulateCommand) Execute(args []string) error {
+	// Parse flags
+	if err := c.ParseFlags(args); err != nil {
+		return errors.NewUsageError(fmt.Sprintf("invalid arguments: %v", err))
+	}
+
+	// Check required arguments
+	if c.NArg() < 1 {
+		c.Usage()
+		return errors.NewUsageError("simulate command requires file path")
+	}
+
+	filePath := c.Arg(0)
+
+	// Validate inputs
+	if err := cli.NewValidationChain().
+		ValidateFilePath(filePath, "file path").
+		ValidateFileExtension(filePath, []string{".json"}, "file type").
+		Error(); err != nil {
+		return err
+	}
+
+	if c.format != "text" && c.format != "json" {
+		return errors.NewValidationError(fmt.Sprintf("invalid format '%s', must be one of: [text json]", c.format), nil)
+	}
+
+	// Simulate the file
+	simulator := &bpmn.FileSimulator{Decisions: c.decisions}
+	result, err := simulator.SimulateFile(filePath)
+	if err != nil {
+		return errors.NewValidationError("simulating BPMN process", err)
+	}
+
+	if c.format == "json" {
+		data, err := json.MarshalIndent(result, "", "  ")
+		if err != nil {
+			return errors.NewIOError("encoding simulation", err)
+		}
+		fmt.Println(string(data))
+	} else {
+		fmt.Print(bpmn.FormatSimulationReport(result))
+	}
+
+	if len(result.Stuck) > 0 {
+		return errors.NewValidationError(fmt.Sprintf("%d token(s) stuck during simulation", len(result.Stuck)), nil)
+	}
+
+	return nil
+}
+
+// Usage prints detailed usage for the BPMN simulate command
+func (c *BPMNSimulateCommand) Usage() {
+	fmt.Println("Play tokens through a BPMN process")
+	fmt.Println()
+	fmt.Println("Usage:")
+	fmt.Println("  workflows bpmn simulate [flags] <file>")
+	fmt.Println()
+	fmt.Println("Flags:")
+	c.FlagSet().PrintDefaults()
+	fmt.Println()
+	fmt.Println("A token starts on each start event. Diverging gateways other than")
+	fmt.Println("parallel ones follow the -decision given for them, or their default")
+	fmt.Println("flow. Activities whose outgoing flows have conditions are choices too:")
+	fmt.Println("they follow their -decision, their default flow or their unconditional")
+	fmt.Println("flows. Parallel gateways split a token into one per outgoing flow and")
+	fmt.Println("join once a token has arrived on every incoming flow.")
+	fmt.Println()
+	fmt.Println("The command prints the activities visited and the end events reached,")
+	fmt.Println("and exits non-zero when a token gets stuck.")
+	fmt.Println()
+	fmt.Println("Examples:")
+	fmt.Println("  workflows bpmn simulate process.json")
+	fmt.Println("  workflows bpmn simulate -decision review_gateway=approved_flow process.json")
+	fmt.Println("  workflows bpmn simulate -decision notify_task=end_approved process.json")
+}
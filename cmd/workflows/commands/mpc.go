@@ -17,6 +17,8 @@ func NewMPCCommand() *MPCCommand {
 	cmd.Register(NewMPCValidateCommand())
 	cmd.Register(NewMPCRenderCommand())
 	cmd.Register(NewMPCDiscoverCommand())
+	cmd.Register(NewMPCGraphCommand())
+	cmd.Register(NewMPCCompleteCommand())
 
 	return cmd
 }
@@ -34,6 +36,8 @@ Available subcommands:
   validate    Validate an MPC workflow file
   render      Render an MPC workflow in different formats
   discover    Discover what tasks can be worked on next
+  graph       Show the node dependency graph (--dot for Graphviz)
+  complete    Mark a subtask or node complete
 
 Examples:
   # Validate an MPC workflow
@@ -45,5 +49,8 @@ Examples:
   # Render an MPC workflow as YAML
   workflows mpc render workflow.yaml --format yaml -o output.yaml
 
+  # Export the dependency graph for Graphviz
+  workflows mpc graph --dot workflow.yaml > workflow.dot
+
 Use "workflows mpc <subcommand> --help" for more information about a subcommand.`
 }
\ No newline at end of file
@@ -0,0 +1,127 @@
+package commands
+
+import (
+	"flag"
+	"fmt"
+	"path/filepath"
+
+	"github.com/mattbarlow-sg/workflows/internal/cli"
+	"github.com/mattbarlow-sg/workflows/internal/errors"
+	"github.com/mattbarlow-sg/workflows/internal/mpc"
+)
+
+type MPCCompleteCommand struct {
+	*cli.BaseCommand
+	node    string
+	subtask int
+	all     bool
+}
+
+func NewMPCCompleteCommand() *MPCCompleteCommand {
+	cmd := &MPCCompleteCommand{
+		BaseCommand: cli.NewBaseCommand("complete", "Mark MPC subtasks or nodes complete"),
+	}
+
+	// Define flags
+	cmd.FlagSet().StringVar(&cmd.node, "node", "", "ID of the node to update")
+	cmd.FlagSet().IntVar(&cmd.subtask, "subtask", 0, "Subtask number to mark complete (1-based)")
+	cmd.FlagSet().BoolVar(&cmd.all, "all", false, "Mark every subtask and the node itself complete")
+
+	return cmd
+}
+
+func (c *MPCCompleteCommand) Execute(args []string) error {
+	// Parse flags
+	if err := c.ParseFlags(args); err != nil {
+		// Check if it's a help request
+		if err == flag.ErrHelp {
+			fmt.Println(c.Help())
+			return nil
+		}
+		return errors.NewUsageError(fmt.Sprintf("invalid arguments: %v", err))
+	}
+
+	// Check required arguments
+	if c.NArg() < 1 {
+		c.Usage()
+		return errors.NewUsageError("complete command requires file path")
+	}
+	if c.node == "" {
+		return errors.NewUsageError("--node is required")
+	}
+	if c.all == (c.subtask != 0) {
+		return errors.NewUsageError("specify exactly one of --subtask or --all")
+	}
+
+	inputFile := c.Arg(0)
+
+	// Validate inputs
+	if err := cli.NewValidationChain().
+		ValidateFilePath(inputFile, "file path").
+		ValidateFileExtension(inputFile, []string{".yaml", ".yml", ".json"}, "file type").
+		Error(); err != nil {
+		return err
+	}
+
+	// Load MPC from file
+	mpcData, err := mpc.LoadMPCFromFile(inputFile)
+	if err != nil {
+		return errors.NewIOError(fmt.Sprintf("failed to load MPC file: %v", err), err)
+	}
+
+	// Artifacts are resolved relative to the MPC file
+	baseDir := filepath.Dir(inputFile)
+	if c.all {
+		err = mpcData.CompleteNode(c.node, baseDir)
+	} else {
+		err = mpcData.CompleteSubtask(c.node, c.subtask, baseDir)
+	}
+	if err != nil {
+		return errors.NewValidationError("cannot complete", err)
+	}
+
+	if err := mpc.SaveProgress(mpcData, inputFile, c.node); err != nil {
+		return errors.NewIOError(fmt.Sprintf("failed to write MPC file: %v", err), err)
+	}
+
+	node := mpcData.GetNodeByID(c.node)
+	fmt.Printf("%s: %d/%d subtasks completed, status %s, materialization %.2f\n",
+		node.ID, node.GetCompletedSubtaskCount(), len(node.Subtasks), node.Status, node.Materialization)
+	return nil
+}
+
+func (c *MPCCompleteCommand) Help() string {
+	return `Mark MPC subtasks or nodes complete
+
+Marks a single subtask, or with --all every subtask of the node, as completed
+and writes the file back. The node's status follows its subtasks: a Ready
+node becomes In Progress once a subtask is done, and any node becomes
+Completed when all of its subtasks are. A subtask whose prerequisites
+(depends_on, numbered from 1 like --subtask) are still incomplete is rejected.
+
+Materialization is recalculated from the node's progress: 80% for the share
+of completed subtasks and 20% for the share of declared artifacts that exist
+on disk (relative paths are resolved against the file's directory). A node
+without declared artifacts is scored on its subtasks alone. Either way a node
+reaches 1.0 once all subtasks are done and its artifacts are in place.
+
+YAML files are updated in place, keeping comments and key order.
+
+Usage:
+  workflows mpc complete --node <id> (--subtask <n> | --all) <file>
+
+Options:
+  --node <id>          ID of the node to update
+  --subtask <n>        Subtask number to mark complete (1-based, as listed by render)
+  --all                Mark every subtask and the node itself complete
+
+Arguments:
+  file                 Path to the MPC workflow file (.yaml, .yml, or .json)
+
+Examples:
+  # Tick off the second subtask of a node
+  workflows mpc complete --node setup-project-structure --subtask 2 workflow.yaml
+
+  # Finish a node outright
+  workflows mpc complete --node setup-project-structure --all workflow.yaml`
+}
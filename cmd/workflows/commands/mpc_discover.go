@@ -3,7 +3,9 @@ package commands
 import (
 	"flag"
 	"fmt"
+	"path/filepath"
 	"strings"
+	"time"
 
 	"github.com/mattbarlow-sg/workflows/internal/cli"
 	"github.com/mattbarlow-sg/workflows/internal/errors"
@@ -14,6 +16,8 @@ type MPCDiscoverCommand struct {
 	*cli.BaseCommand
 	showStatus bool
 	showProgress bool
+	verifyArtifacts bool
+	subtaskDuration time.Duration
 }
 
 func NewMPCDiscoverCommand() *MPCDiscoverCommand {
@@ -24,6 +28,8 @@ func NewMPCDiscoverCommand() *MPCDiscoverCommand {
 	// Define flags
 	cmd.FlagSet().BoolVar(&cmd.showStatus, "status", false, "Show node status")
 	cmd.FlagSet().BoolVar(&cmd.showProgress, "progress", false, "Show progress indicators")
+	cmd.FlagSet().BoolVar(&cmd.verifyArtifacts, "verify-artifacts", false, "Check that declared artifact files exist")
+	cmd.FlagSet().DurationVar(&cmd.subtaskDuration, "subtask-duration", time.Hour, "Expected time per subtask for the remaining estimate")
 	
 	return cmd
 }
@@ -69,6 +75,13 @@ func (c *MPCDiscoverCommand) Execute(args []string) error {
 	// Analyze and display workflow state
 	c.analyzeWorkflow(mpcData)
 
+	// Verify artifact paths relative to the MPC file
+	if c.verifyArtifacts {
+		if missing := c.showArtifactVerification(mpcData, filepath.Dir(inputFile)); missing > 0 {
+			return errors.NewValidationError(fmt.Sprintf("%d node(s) reference missing artifacts", missing), nil)
+		}
+	}
+
 	return nil
 }
 
@@ -177,6 +190,13 @@ func (c *MPCDiscoverCommand) analyzeWorkflow(mpcData *mpc.MPC) {
 	if len(mpcData.Nodes) > 0 {
 		completionRate := float64(len(completedNodes)) / float64(len(mpcData.Nodes)) * 100
 		fmt.Printf("  Overall completion: %.1f%%\n", completionRate)
+		
+		estimate := mpcData.Estimate(c.subtaskDuration)
+		fmt.Printf("  Estimated remaining: %s (%d subtasks on critical path at %s each)\n",
+			estimate.Remaining, estimate.RemainingSubtasks, c.subtaskDuration)
+		if estimate.RemainingSubtasks > 0 {
+			fmt.Printf("  Critical path: %s\n", strings.Join(estimate.CriticalPath, " → "))
+		}
 	}
 }
 
@@ -193,6 +213,10 @@ func (c *MPCDiscoverCommand) printNodeSummary(node *mpc.Node) {
 		fmt.Printf("     Subtasks: %d/%d completed\n", completedCount, totalCount)
 	}
 	
+	if next := node.NextActionableSubtasks(); len(next) > 0 && (node.Status == mpc.StatusReady || node.Status == mpc.StatusInProgress) {
+		fmt.Printf("     Next subtask: %d. %s\n", next[0], node.Subtasks[next[0]-1].Description)
+	}
+	
 	if len(node.Downstream) > 0 {
 		fmt.Printf("     Unlocks: %s\n", strings.Join(node.Downstream, ", "))
 	}
@@ -302,6 +326,37 @@ func (c *MPCDiscoverCommand) buildExecutionStages(mpcData *mpc.MPC) [][]string {
 }
 
 
+// showArtifactVerification prints nodes with missing or undeclared artifacts
+// and returns the number of nodes with missing artifacts
+func (c *MPCDiscoverCommand) showArtifactVerification(mpcData *mpc.MPC, baseDir string) int {
+	fmt.Println("\n📦 ARTIFACT VERIFICATION:")
+	fmt.Println(strings.Repeat("=", 60))
+	
+	checks := mpc.VerifyArtifacts(mpcData, baseDir)
+	missing := 0
+	undeclared := []string{}
+	for _, check := range checks {
+		if check.Undeclared {
+			undeclared = append(undeclared, check.NodeID)
+			continue
+		}
+		missing++
+		fmt.Printf("  ✗ %s: missing artifacts\n", check.NodeID)
+		for _, artifact := range check.Missing {
+			fmt.Printf("     - %s: %s\n", artifact.Field, artifact.Path)
+		}
+	}
+	
+	if missing == 0 {
+		fmt.Println("  ✓ All declared artifacts exist")
+	}
+	if len(undeclared) > 0 {
+		fmt.Printf("  ○ No artifacts declared: %s\n", strings.Join(undeclared, ", "))
+	}
+	
+	return missing
+}
+
 func (c *MPCDiscoverCommand) getStatusIcon(status string) string {
 	switch status {
 	case mpc.StatusReady:
@@ -332,6 +387,13 @@ Usage:
 Options:
   --status     Show node status (deprecated, always shown)
   --progress   Show detailed progress information
+  --subtask-duration <duration>
+               Expected time per subtask, used for the "Estimated remaining"
+               projection along the critical path (default: 1h)
+  --verify-artifacts
+               Check that artifact files declared on each node exist,
+               resolving relative paths against the MPC file's directory.
+               Exits with an error when any declared artifact is missing.
 
 Arguments:
   file         Path to the MPC workflow file (.yaml, .yml, or .json)
@@ -342,6 +404,7 @@ Display Sections:
   🔒 BLOCKED: Tasks waiting on dependencies
   📋 EXECUTION STAGES: Ordered stages showing parallel/sequential flow
   📊 SUMMARY: Overall workflow statistics
+  📦 ARTIFACT VERIFICATION: Missing and undeclared artifacts (--verify-artifacts)
 
 Status Icons:
   ○ Ready, ◐ In Progress, ■ Blocked, ● Completed
@@ -351,5 +414,8 @@ Examples:
   workflows mpc discover workflow.yaml
 
   # Show with detailed progress
-  workflows mpc discover workflow.yaml --progress`
+  workflows mpc discover workflow.yaml --progress
+
+  # Check that artifact files exist
+  workflows mpc discover --verify-artifacts workflow.yaml`
 }
\ No newline at end of file
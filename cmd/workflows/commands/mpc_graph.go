@@ -0,0 +1,112 @@
+package commands
+
+import (
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/mattbarlow-sg/workflows/internal/cli"
+	"github.com/mattbarlow-sg/workflows/internal/errors"
+	"github.com/mattbarlow-sg/workflows/internal/mpc"
+)
+
+type MPCGraphCommand struct {
+	*cli.BaseCommand
+	dot    bool
+	output string
+}
+
+func NewMPCGraphCommand() *MPCGraphCommand {
+	cmd := &MPCGraphCommand{
+		BaseCommand: cli.NewBaseCommand("graph", "Show the MPC node dependency graph"),
+	}
+
+	// Define flags
+	cmd.FlagSet().BoolVar(&cmd.dot, "dot", false, "Output Graphviz DOT")
+	cmd.FlagSet().StringVar(&cmd.output, "output", "", "Output file (default: stdout)")
+
+	return cmd
+}
+
+func (c *MPCGraphCommand) Execute(args []string) error {
+	// Parse flags
+	if err := c.ParseFlags(args); err != nil {
+		// Check if it's a help request
+		if err == flag.ErrHelp {
+			fmt.Println(c.Help())
+			return nil
+		}
+		return errors.NewUsageError(fmt.Sprintf("invalid arguments: %v", err))
+	}
+
+	// Check required arguments
+	if c.NArg() < 1 {
+		c.Usage()
+		return errors.NewUsageError("graph command requires file path")
+	}
+
+	inputFile := c.Arg(0)
+
+	// Validate inputs
+	if err := cli.NewValidationChain().
+		ValidateFilePath(inputFile, "file path").
+		ValidateFileExtension(inputFile, []string{".yaml", ".yml", ".json"}, "file type").
+		Error(); err != nil {
+		return err
+	}
+
+	// Load MPC from file
+	mpcData, err := mpc.LoadMPCFromFile(inputFile)
+	if err != nil {
+		return errors.NewIOError(fmt.Sprintf("failed to load MPC file: %v", err), err)
+	}
+
+	renderer := mpc.NewRenderer(mpcData)
+	content := renderer.RenderGraphText()
+	if c.dot {
+		if content, err = renderer.Render("dot"); err != nil {
+			return errors.NewIOError(fmt.Sprintf("failed to render MPC graph: %v", err), err)
+		}
+	}
+
+	// Output result
+	if c.output != "" {
+		if err := os.WriteFile(c.output, []byte(content), 0644); err != nil {
+			return errors.NewIOError(fmt.Sprintf("failed to write output file: %v", err), err)
+		}
+		fmt.Printf("MPC graph written to %s\n", c.output)
+	} else {
+		fmt.Print(content)
+	}
+
+	return nil
+}
+
+func (c *MPCGraphCommand) Help() string {
+	return `Show the MPC node dependency graph
+
+Without flags, prints each node with its status and downstream nodes.
+With --dot, emits a Graphviz digraph where nodes are colored by status and
+the entry node is drawn with a double border. Nodes and edges are sorted
+by ID so the output is stable across runs.
+
+Usage:
+  workflows mpc graph [options] <file>
+
+Options:
+  --dot                Output Graphviz DOT
+  --output <file>      Output file (default: stdout)
+
+Arguments:
+  file                 Path to the MPC workflow file (.yaml, .yml, or .json)
+
+Node Colors:
+  Ready: lightblue, In Progress: gold, Blocked: lightcoral, Completed: palegreen
+
+Examples:
+  # Print the dependency edges
+  workflows mpc graph workflow.yaml
+
+  # Render an SVG with Graphviz
+  workflows mpc graph --dot workflow.yaml | dot -Tsvg -o workflow.svg`
+}
@@ -23,7 +23,7 @@ func NewMPCRenderCommand() *MPCRenderCommand {
 	}
 	
 	// Define flags
-	cmd.FlagSet().StringVar(&cmd.format, "format", "yaml", "Output format (yaml, json, text)")
+	cmd.FlagSet().StringVar(&cmd.format, "format", "yaml", "Output format (yaml, json, text, dot)")
 	cmd.FlagSet().StringVar(&cmd.output, "output", "", "Output file (default: stdout)")
 	
 	return cmd
@@ -57,7 +57,7 @@ func (c *MPCRenderCommand) Execute(args []string) error {
 	}
 
 	// Validate format
-	validFormats := []string{"yaml", "json", "text"}
+	validFormats := []string{"yaml", "json", "text", "dot"}
 	if !contains(validFormats, c.format) {
 		return errors.NewUsageError(fmt.Sprintf("invalid format '%s'. Valid formats: %s", c.format, strings.Join(validFormats, ", ")))
 	}
@@ -103,7 +103,7 @@ Usage:
   workflows mpc render [options] <file>
 
 Options:
-  --format <format>    Output format: yaml, json, text (default: yaml)
+  --format <format>    Output format: yaml, json, text, dot (default: yaml)
   -o, --output <file>  Output file (default: stdout)
 
 Arguments:
@@ -113,6 +113,7 @@ Output Formats:
   yaml    YAML format (preserves structure)
   json    JSON format (structured data)
   text    Human-readable text summary with statistics
+  dot     Graphviz DOT dependency graph (same as "mpc graph --dot")
 
 Examples:
   # Render to stdout in YAML format
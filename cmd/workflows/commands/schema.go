@@ -0,0 +1,38 @@
+package commands
+
+import (
+	"github.com/mattbarlow-sg/workflows/internal/cli"
+)
+
+// SchemaCommand implements the schema parent command with subcommands
+type SchemaCommand struct {
+	*cli.SubcommandHandler
+}
+
+// NewSchemaCommand creates a new schema command
+func NewSchemaCommand() *SchemaCommand {
+	cmd := &SchemaCommand{
+		SubcommandHandler: cli.NewSubcommandHandler(
+			"schema",
+			"JSON schema commands",
+		),
+	}
+
+	// Register subcommands
+	cmd.Register(NewSchemaDiffCommand())
+	cmd.Register(NewSchemaExampleCommand())
+
+	return cmd
+}
+
+// Usage prints schema command usage with examples
+func (c *SchemaCommand) Usage() {
+	// Call parent usage first
+	c.SubcommandHandler.Usage()
+
+	// Add examples
+	println()
+	println("Examples:")
+	println("  workflows schema diff schemas/user-v1.json schemas/user.json")
+	println("  workflows schema example user")
+}
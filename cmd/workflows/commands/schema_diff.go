@@ -0,0 +1,109 @@
+package commands
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/mattbarlow-sg/workflows/internal/cli"
+	"github.com/mattbarlow-sg/workflows/internal/errors"
+	"github.com/mattbarlow-sg/workflows/internal/schema"
+)
+
+// SchemaDiffCommand implements the schema diff subcommand
+type SchemaDiffCommand struct {
+	*cli.BaseCommand
+	format string
+	strict bool
+}
+
+// NewSchemaDiffCommand creates a new schema diff command
+func NewSchemaDiffCommand() *SchemaDiffCommand {
+	cmd := &SchemaDiffCommand{
+		BaseCommand: cli.NewBaseCommand(
+			"diff",
+			"Compare two versions of a JSON schema",
+		),
+	}
+
+	// Define flags
+	cmd.FlagSet().StringVar(&cmd.format, "format", "text", "Output format: text, json")
+	cmd.FlagSet().BoolVar(&cmd.strict, "strict", false, "Exit with an error when breaking changes are found")
+
+	return cmd
+}
+
+// Execute runs the schema diff command
+func (c *SchemaDiffCommand) Execute(args []string) error {
+	// Parse flags
+	if err := c.ParseFlags(args); err != nil {
+		return errors.NewUsageError(fmt.Sprintf("invalid arguments: %v", err))
+	}
+
+	// Check required arguments
+	if c.NArg() != 2 {
+		c.Usage()
+		return errors.NewUsageError("diff command requires old and new schema paths")
+	}
+
+	oldPath, newPath := c.Arg(0), c.Arg(1)
+
+	// Validate inputs
+	if err := cli.NewValidationChain().
+		ValidateFilePath(oldPath, "old schema path").
+		ValidateFileExtension(oldPath, []string{".json"}, "old schema type").
+		ValidateFilePath(newPath, "new schema path").
+		ValidateFileExtension(newPath, []string{".json"}, "new schema type").
+		Error(); err != nil {
+		return err
+	}
+
+	if c.format != "text" && c.format != "json" {
+		return errors.NewValidationError(fmt.Sprintf("invalid format '%s', must be one of: [text json]", c.format), nil)
+	}
+
+	oldSchema, err := schema.LoadFile(oldPath)
+	if err != nil {
+		return errors.NewIOError("loading old schema", err)
+	}
+	newSchema, err := schema.LoadFile(newPath)
+	if err != nil {
+		return errors.NewIOError("loading new schema", err)
+	}
+
+	diff := schema.DiffSchemas(oldSchema.Content, newSchema.Content)
+
+	if c.format == "json" {
+		data, err := json.MarshalIndent(diff, "", "  ")
+		if err != nil {
+			return errors.NewIOError("encoding diff", err)
+		}
+		fmt.Println(string(data))
+	} else {
+		fmt.Print(schema.FormatSchemaDiff(diff))
+	}
+
+	if c.strict && diff.HasBreaking() {
+		return errors.NewValidationError("breaking schema changes found", nil)
+	}
+
+	return nil
+}
+
+// Usage prints detailed usage for the schema diff command
+func (c *SchemaDiffCommand) Usage() {
+	fmt.Println("Compare two versions of a JSON schema")
+	fmt.Println()
+	fmt.Println("Usage:")
+	fmt.Println("  workflows schema diff [flags] <old-schema> <new-schema>")
+	fmt.Println()
+	fmt.Println("Flags:")
+	c.FlagSet().PrintDefaults()
+	fmt.Println()
+	fmt.Println("Reports added and removed properties, type changes and required-field")
+	fmt.Println("changes. Removing a property, requiring an existing one, or narrowing a")
+	fmt.Println("type is breaking; other changes are compatible.")
+	fmt.Println()
+	fmt.Println("Examples:")
+	fmt.Println("  workflows schema diff schemas/user-v1.json schemas/user.json")
+	fmt.Println("  workflows schema diff -strict -format json old.json new.json")
+}
@@ -0,0 +1,90 @@
+package commands
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/mattbarlow-sg/workflows/internal/cli"
+	"github.com/mattbarlow-sg/workflows/internal/config"
+	"github.com/mattbarlow-sg/workflows/internal/errors"
+	"github.com/mattbarlow-sg/workflows/internal/schema"
+)
+
+// SchemaExampleCommand implements the schema example subcommand
+type SchemaExampleCommand struct {
+	*cli.BaseCommand
+}
+
+// NewSchemaExampleCommand creates a new schema example command
+func NewSchemaExampleCommand() *SchemaExampleCommand {
+	return &SchemaExampleCommand{
+		BaseCommand: cli.NewBaseCommand(
+			"example",
+			"Generate a minimal example document for a schema",
+		),
+	}
+}
+
+// Execute runs the schema example command
+func (c *SchemaExampleCommand) Execute(args []string) error {
+	// Parse flags
+	if err := c.ParseFlags(args); err != nil {
+		return errors.NewUsageError(fmt.Sprintf("invalid arguments: %v", err))
+	}
+
+	// Check required arguments
+	if c.NArg() != 1 {
+		c.Usage()
+		return errors.NewUsageError("example command requires a schema name")
+	}
+
+	schemaName := c.Arg(0)
+
+	if err := cli.NewValidationChain().
+		ValidateSchemaName(schemaName, "schema name").
+		Error(); err != nil {
+		return err
+	}
+
+	// Load configuration and registry
+	cfg := config.New()
+	registry := schema.NewRegistry(cfg.SchemaDir)
+
+	if err := registry.Discover(); err != nil {
+		return errors.NewConfigError("discovering schemas", err)
+	}
+
+	schemaObj, found := registry.Get(schemaName)
+	if !found {
+		fmt.Fprintln(os.Stderr, "Use 'workflows list' to see available schemas")
+		return errors.NewValidationError(fmt.Sprintf("schema '%s' not found", schemaName), nil)
+	}
+
+	example, err := schema.GenerateExample(*schemaObj)
+	if err != nil {
+		return errors.NewValidationError(fmt.Sprintf("generating example for schema '%s'", schemaName), err)
+	}
+
+	fmt.Println(string(example))
+	return nil
+}
+
+// Usage prints detailed usage for the schema example command
+func (c *SchemaExampleCommand) Usage() {
+	fmt.Println("Generate a minimal example document for a schema")
+	fmt.Println()
+	fmt.Println("Usage:")
+	fmt.Println("  workflows schema example <schema-name>")
+	fmt.Println()
+	fmt.Println("Only required properties are included. Defaults, constants and the first")
+	fmt.Println("enum value are used where declared, strings are built to match their")
+	fmt.Println("pattern, and arrays contain a single element. References to sibling")
+	fmt.Println("schema files are resolved from the schema directory.")
+	fmt.Println()
+	fmt.Println("The example is validated against the schema; the command fails when it")
+	fmt.Println("cannot produce one that validates.")
+	fmt.Println()
+	fmt.Println("Examples:")
+	fmt.Println("  workflows schema example user")
+	fmt.Println("  workflows schema example config > config.json")
+}
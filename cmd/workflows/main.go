@@ -37,6 +37,10 @@ func run() error {
 		return err
 	}
 	
+	if err := manager.Register(commands.NewSchemaCommand()); err != nil {
+		return err
+	}
+	
 	if err := manager.Register(commands.NewADRCommand()); err != nil {
 		return err
 	}
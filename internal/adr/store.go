@@ -0,0 +1,320 @@
+package adr
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+)
+
+// DateFormat is the layout of the ADR decision date
+const DateFormat = "2006-01-02"
+
+// Supersession relationship types used in AIMetadata.Dependencies
+const (
+	RelationshipSupersedes   = "supersedes"
+	RelationshipSupersededBy = "superseded-by"
+)
+
+// ADRError describes a problem found across a collection of ADRs
+type ADRError struct {
+	ADRID   string
+	Type    string // "dangling-reference", "supersession-cycle"
+	Message string
+}
+
+func (e ADRError) Error() string {
+	return fmt.Sprintf("%s: %s", e.ADRID, e.Message)
+}
+
+// ADRStore holds a collection of ADRs loaded from a directory
+type ADRStore struct {
+	dir   string
+	adrs  map[string]*ADR
+	paths map[string]string
+}
+
+// NewADRStore creates a new ADR store for the given directory
+func NewADRStore(dir string) *ADRStore {
+	return &ADRStore{
+		dir:   dir,
+		adrs:  make(map[string]*ADR),
+		paths: make(map[string]string),
+	}
+}
+
+// Load reads all ADR JSON files from the store directory
+func (s *ADRStore) Load() error {
+	s.adrs = make(map[string]*ADR)
+	s.paths = make(map[string]string)
+
+	entries, err := os.ReadDir(s.dir)
+	if err != nil {
+		return fmt.Errorf("reading ADR directory: %w", err)
+	}
+
+	for _, entry := range entries {
+		if entry.IsDir() || !strings.HasSuffix(entry.Name(), ".json") {
+			continue
+		}
+
+		path := filepath.Join(s.dir, entry.Name())
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return fmt.Errorf("reading %s: %w", path, err)
+		}
+
+		a, err := FromJSON(data)
+		if err != nil {
+			return fmt.Errorf("parsing %s: %w", path, err)
+		}
+
+		if err := s.Add(a); err != nil {
+			return fmt.Errorf("loading %s: %w", path, err)
+		}
+		s.paths[a.ID] = path
+	}
+
+	return nil
+}
+
+// Add adds an ADR to the store
+func (s *ADRStore) Add(a *ADR) error {
+	if a.ID == "" {
+		return fmt.Errorf("ADR has no ID")
+	}
+	if _, exists := s.adrs[a.ID]; exists {
+		return fmt.Errorf("duplicate ADR ID: %s", a.ID)
+	}
+	s.adrs[a.ID] = a
+	return nil
+}
+
+// Get returns the ADR with the given ID
+func (s *ADRStore) Get(id string) (*ADR, bool) {
+	a, ok := s.adrs[id]
+	return a, ok
+}
+
+// Path returns the file an ADR was loaded from
+func (s *ADRStore) Path(id string) string {
+	return s.paths[id]
+}
+
+// List returns all ADRs sorted by ID
+func (s *ADRStore) List() []ADR {
+	adrs := make([]ADR, 0, len(s.adrs))
+	for _, id := range s.sortedIDs() {
+		adrs = append(adrs, *s.adrs[id])
+	}
+	return adrs
+}
+
+// ADRFilter selects ADRs by status, tag, author and decision date.
+// Empty fields match everything; Since and Until are inclusive.
+type ADRFilter struct {
+	Status string
+	Tag    string
+	Author string
+	Since  time.Time
+	Until  time.Time
+}
+
+// Matches reports whether an ADR satisfies the filter
+func (f ADRFilter) Matches(a *ADR) bool {
+	if f.Status != "" && !strings.EqualFold(a.Status, f.Status) {
+		return false
+	}
+
+	if f.Tag != "" {
+		if a.AIMetadata == nil || !containsFold(a.AIMetadata.Tags, f.Tag) {
+			return false
+		}
+	}
+
+	if f.Author != "" {
+		if a.Stakeholders == nil || !containsFold(a.Stakeholders.Deciders, f.Author) {
+			return false
+		}
+	}
+
+	if !f.Since.IsZero() || !f.Until.IsZero() {
+		date, err := time.Parse(DateFormat, a.Date)
+		if err != nil {
+			return false
+		}
+		if !f.Since.IsZero() && date.Before(f.Since) {
+			return false
+		}
+		if !f.Until.IsZero() && date.After(f.Until) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Query returns the ADRs matching the filter, sorted by date then ID
+func (s *ADRStore) Query(filter ADRFilter) []ADR {
+	var results []ADR
+	for _, id := range s.sortedIDs() {
+		if a := s.adrs[id]; filter.Matches(a) {
+			results = append(results, *a)
+		}
+	}
+
+	sort.SliceStable(results, func(i, j int) bool {
+		if results[i].Date != results[j].Date {
+			return results[i].Date < results[j].Date
+		}
+		return results[i].ID < results[j].ID
+	})
+
+	return results
+}
+
+// ValidateSupersessions checks that every supersedes/superseded-by reference
+// resolves to a known ADR and that no ADRs supersede each other in a cycle
+func (s *ADRStore) ValidateSupersessions() []ADRError {
+	var errs []ADRError
+
+	for _, id := range s.sortedIDs() {
+		a := s.adrs[id]
+		if a.AIMetadata == nil {
+			continue
+		}
+		for _, dep := range a.AIMetadata.Dependencies {
+			if dep.Relationship != RelationshipSupersedes && dep.Relationship != RelationshipSupersededBy {
+				continue
+			}
+			if _, ok := s.adrs[dep.ADRID]; !ok {
+				errs = append(errs, ADRError{
+					ADRID:   id,
+					Type:    "dangling-reference",
+					Message: fmt.Sprintf("%s reference to unknown ADR '%s'", dep.Relationship, dep.ADRID),
+				})
+			}
+		}
+	}
+
+	for _, cycle := range s.findSupersessionCycles() {
+		errs = append(errs, ADRError{
+			ADRID:   cycle[0],
+			Type:    "supersession-cycle",
+			Message: fmt.Sprintf("supersession cycle: %s", strings.Join(append(cycle, cycle[0]), " -> ")),
+		})
+	}
+
+	return errs
+}
+
+// CurrentDecisions returns the ADRs that have not been superseded, sorted by ID
+func (s *ADRStore) CurrentDecisions() []ADR {
+	superseded := make(map[string]bool)
+	for from, targets := range s.supersessionGraph() {
+		for _, to := range targets {
+			if _, ok := s.adrs[from]; ok {
+				superseded[to] = true
+			}
+		}
+	}
+
+	var current []ADR
+	for _, id := range s.sortedIDs() {
+		a := s.adrs[id]
+		if superseded[id] || a.Status == "superseded" {
+			continue
+		}
+		current = append(current, *a)
+	}
+	return current
+}
+
+// supersessionGraph maps each ADR ID to the IDs it supersedes, combining
+// "supersedes" links with the inverse of "superseded-by" links
+func (s *ADRStore) supersessionGraph() map[string][]string {
+	graph := make(map[string][]string)
+	add := func(from, to string) {
+		for _, existing := range graph[from] {
+			if existing == to {
+				return
+			}
+		}
+		graph[from] = append(graph[from], to)
+	}
+
+	for _, id := range s.sortedIDs() {
+		a := s.adrs[id]
+		if a.AIMetadata == nil {
+			continue
+		}
+		for _, dep := range a.AIMetadata.Dependencies {
+			switch dep.Relationship {
+			case RelationshipSupersedes:
+				add(id, dep.ADRID)
+			case RelationshipSupersededBy:
+				add(dep.ADRID, id)
+			}
+		}
+	}
+
+	return graph
+}
+
+// findSupersessionCycles returns each distinct cycle in the supersession graph
+func (s *ADRStore) findSupersessionCycles() [][]string {
+	graph := s.supersessionGraph()
+	visited := make(map[string]bool)
+	onStack := make(map[string]bool)
+	var cycles [][]string
+
+	var visit func(id string, path []string)
+	visit = func(id string, path []string) {
+		visited[id] = true
+		onStack[id] = true
+		path = append(path, id)
+
+		for _, next := range graph[id] {
+			if onStack[next] {
+				for i, n := range path {
+					if n == next {
+						cycles = append(cycles, append([]string{}, path[i:]...))
+						break
+					}
+				}
+			} else if !visited[next] {
+				visit(next, path)
+			}
+		}
+
+		onStack[id] = false
+	}
+
+	for _, id := range s.sortedIDs() {
+		if !visited[id] {
+			visit(id, nil)
+		}
+	}
+
+	return cycles
+}
+
+func (s *ADRStore) sortedIDs() []string {
+	ids := make([]string, 0, len(s.adrs))
+	for id := range s.adrs {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	return ids
+}
+
+func containsFold(values []string, target string) bool {
+	for _, v := range values {
+		if strings.EqualFold(v, target) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,199 @@
+package adr
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func newTestADR(id, status string, deps ...Dependency) *ADR {
+	a := &ADR{
+		ID:     id,
+		Title:  id,
+		Status: status,
+		Date:   "2024-01-01",
+	}
+	if len(deps) > 0 {
+		a.AIMetadata = &AIMetadata{Dependencies: deps}
+	}
+	return a
+}
+
+func TestADRStoreLoad(t *testing.T) {
+	dir := t.TempDir()
+	for _, a := range []*ADR{
+		newTestADR("ADR-0001", "superseded"),
+		newTestADR("ADR-0002", "accepted", Dependency{ADRID: "ADR-0001", Relationship: "supersedes"}),
+	} {
+		data, err := a.ToJSON()
+		if err != nil {
+			t.Fatalf("ToJSON() error = %v", err)
+		}
+		if err := os.WriteFile(filepath.Join(dir, a.ID+".json"), []byte(data), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// Non-JSON files are ignored
+	if err := os.WriteFile(filepath.Join(dir, "README.md"), []byte("# ADRs"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	store := NewADRStore(dir)
+	if err := store.Load(); err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+
+	if got := len(store.List()); got != 2 {
+		t.Errorf("List() returned %d ADRs, want 2", got)
+	}
+	if errs := store.ValidateSupersessions(); len(errs) != 0 {
+		t.Errorf("ValidateSupersessions() = %v, want none", errs)
+	}
+	if store.Path("ADR-0002") != filepath.Join(dir, "ADR-0002.json") {
+		t.Errorf("Path() = %s", store.Path("ADR-0002"))
+	}
+}
+
+func TestADRStoreDanglingReference(t *testing.T) {
+	store := NewADRStore("")
+	store.Add(newTestADR("ADR-0001", "accepted",
+		Dependency{ADRID: "ADR-0099", Relationship: "supersedes"},
+		Dependency{ADRID: "ADR-0098", Relationship: "relates-to"}, // Not a supersession link
+	))
+
+	errs := store.ValidateSupersessions()
+	if len(errs) != 1 {
+		t.Fatalf("ValidateSupersessions() returned %d errors, want 1: %v", len(errs), errs)
+	}
+	if errs[0].Type != "dangling-reference" || errs[0].ADRID != "ADR-0001" {
+		t.Errorf("Unexpected error: %+v", errs[0])
+	}
+}
+
+func TestADRStoreSupersessionCycle(t *testing.T) {
+	store := NewADRStore("")
+	// ADR-0001 superseded-by ADR-0003 closes the cycle 0001 -> 0002 -> 0003 -> 0001
+	store.Add(newTestADR("ADR-0001", "accepted",
+		Dependency{ADRID: "ADR-0002", Relationship: "supersedes"},
+		Dependency{ADRID: "ADR-0003", Relationship: "superseded-by"},
+	))
+	store.Add(newTestADR("ADR-0002", "accepted", Dependency{ADRID: "ADR-0003", Relationship: "supersedes"}))
+	store.Add(newTestADR("ADR-0003", "accepted"))
+
+	errs := store.ValidateSupersessions()
+	if len(errs) != 1 {
+		t.Fatalf("ValidateSupersessions() returned %d errors, want 1: %v", len(errs), errs)
+	}
+	if errs[0].Type != "supersession-cycle" {
+		t.Errorf("Error type = %s, want supersession-cycle", errs[0].Type)
+	}
+	want := "supersession cycle: ADR-0001 -> ADR-0002 -> ADR-0003 -> ADR-0001"
+	if errs[0].Message != want {
+		t.Errorf("Message = %q, want %q", errs[0].Message, want)
+	}
+}
+
+func TestADRStoreCurrentDecisions(t *testing.T) {
+	store := NewADRStore("")
+	store.Add(newTestADR("ADR-0001", "accepted"))
+	store.Add(newTestADR("ADR-0002", "accepted", Dependency{ADRID: "ADR-0001", Relationship: "supersedes"}))
+	store.Add(newTestADR("ADR-0003", "accepted", Dependency{ADRID: "ADR-0004", Relationship: "superseded-by"}))
+	store.Add(newTestADR("ADR-0004", "proposed"))
+	store.Add(newTestADR("ADR-0005", "superseded"))
+
+	current := store.CurrentDecisions()
+	var ids []string
+	for _, a := range current {
+		ids = append(ids, a.ID)
+	}
+
+	want := []string{"ADR-0002", "ADR-0004"}
+	if len(ids) != len(want) {
+		t.Fatalf("CurrentDecisions() = %v, want %v", ids, want)
+	}
+	for i := range want {
+		if ids[i] != want[i] {
+			t.Errorf("CurrentDecisions()[%d] = %s, want %s", i, ids[i], want[i])
+		}
+	}
+}
+
+func TestADRStoreDuplicateID(t *testing.T) {
+	store := NewADRStore("")
+	if err := store.Add(newTestADR("ADR-0001", "accepted")); err != nil {
+		t.Fatalf("Add() error = %v", err)
+	}
+	if err := store.Add(newTestADR("ADR-0001", "draft")); err == nil {
+		t.Error("Add() should reject duplicate IDs")
+	}
+}
+
+func TestADRStoreQuery(t *testing.T) {
+	store := NewADRStore("")
+
+	a1 := newTestADR("ADR-0001", "accepted")
+	a1.Date = "2023-11-20"
+	a1.AIMetadata = &AIMetadata{Tags: []string{"database"}}
+	a1.Stakeholders = &Stakeholders{Deciders: []string{"alice"}}
+
+	a2 := newTestADR("ADR-0002", "accepted")
+	a2.Date = "2024-03-01"
+	a2.AIMetadata = &AIMetadata{Tags: []string{"database", "scaling"}}
+	a2.Stakeholders = &Stakeholders{Deciders: []string{"bob"}}
+
+	a3 := newTestADR("ADR-0003", "proposed")
+	a3.Date = "2024-02-15"
+	a3.AIMetadata = &AIMetadata{Tags: []string{"database"}}
+	a3.Stakeholders = &Stakeholders{Deciders: []string{"alice"}}
+
+	a4 := newTestADR("ADR-0004", "accepted")
+	a4.Date = "2024-02-15"
+	a4.Stakeholders = &Stakeholders{Deciders: []string{"alice", "bob"}}
+
+	// Added out of order to check sorting
+	for _, a := range []*ADR{a2, a4, a3, a1} {
+		store.Add(a)
+	}
+
+	tests := []struct {
+		name   string
+		filter ADRFilter
+		want   []string
+	}{
+		{"no filter sorted by date then ID", ADRFilter{}, []string{"ADR-0001", "ADR-0003", "ADR-0004", "ADR-0002"}},
+		{"status and since", ADRFilter{Status: "accepted", Since: mustDate(t, "2024-01-01")}, []string{"ADR-0004", "ADR-0002"}},
+		{"tag and author", ADRFilter{Tag: "database", Author: "alice"}, []string{"ADR-0001", "ADR-0003"}},
+		{"date range inclusive", ADRFilter{Since: mustDate(t, "2024-02-15"), Until: mustDate(t, "2024-02-15")}, []string{"ADR-0003", "ADR-0004"}},
+		{"all filters combined", ADRFilter{Status: "accepted", Tag: "scaling", Author: "bob", Until: mustDate(t, "2024-12-31")}, []string{"ADR-0002"}},
+		{"empty result", ADRFilter{Status: "rejected"}, nil},
+		{"empty result for date range", ADRFilter{Since: mustDate(t, "2025-01-01")}, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []string
+			for _, a := range store.Query(tt.filter) {
+				got = append(got, a.ID)
+			}
+			if len(got) != len(tt.want) {
+				t.Fatalf("Query() = %v, want %v", got, tt.want)
+			}
+			for i := range tt.want {
+				if got[i] != tt.want[i] {
+					t.Errorf("Query() = %v, want %v", got, tt.want)
+					break
+				}
+			}
+		})
+	}
+}
+
+func mustDate(t *testing.T, s string) time.Time {
+	t.Helper()
+	d, err := time.Parse(DateFormat, s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return d
+}
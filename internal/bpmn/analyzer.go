@@ -2,6 +2,8 @@ package bpmn
 
 import (
 	"fmt"
+	"maps"
+	"slices"
 	"sort"
 	"strings"
 )
@@ -13,6 +15,37 @@ type AnalysisResult struct {
 	Paths          PathAnalysis          `json:"paths"`
 	Metrics        ProcessMetrics        `json:"metrics"`
 	AgentWorkload  AgentWorkloadAnalysis `json:"agent_workload"`
+	DanglingFlows  []string              `json:"dangling_flows,omitempty"`
+	LintWarnings   []LintWarning         `json:"lint_warnings,omitempty"`
+	MessageFlowIssues []MessageFlowIssue `json:"message_flow_issues,omitempty"`
+	Thresholds     *MetricThresholds     `json:"thresholds,omitempty"`
+	Violations     []ThresholdViolation  `json:"threshold_violations,omitempty"`
+}
+
+// StrictIssues summarizes the findings that fail analysis in strict mode:
+// potential deadlocks, elements unreachable from a start event, lint errors
+// and message flow issues
+func (r *AnalysisResult) StrictIssues() []string {
+	var issues []string
+	if len(r.Deadlocks) > 0 {
+		issues = append(issues, fmt.Sprintf("%d potential deadlock(s)", len(r.Deadlocks)))
+	}
+	if n := len(r.Reachability.UnreachableElements); n > 0 {
+		issues = append(issues, fmt.Sprintf("%d unreachable element(s): %s", n, strings.Join(r.Reachability.UnreachableElements, ", ")))
+	}
+	var lintErrors []string
+	for _, w := range r.LintWarnings {
+		if w.IsError() {
+			lintErrors = append(lintErrors, w.Message)
+		}
+	}
+	if len(lintErrors) > 0 {
+		issues = append(issues, fmt.Sprintf("%d lint error(s): %s", len(lintErrors), strings.Join(lintErrors, ", ")))
+	}
+	if len(r.MessageFlowIssues) > 0 {
+		issues = append(issues, messageFlowSummary(r.MessageFlowIssues))
+	}
+	return issues
 }
 
 // ReachabilityAnalysis contains reachability information
@@ -21,13 +54,15 @@ type ReachabilityAnalysis struct {
 	DeadEndElements     []string          `json:"dead_end_elements"`
 	ReachableFromStart  map[string]bool   `json:"reachable_from_start"`
 	ReachesEnd          map[string]bool   `json:"reaches_end"`
+	Bounds              map[string]Bounds `json:"bounds,omitempty"` // diagram placement of unreachable and dead-end elements
 }
 
 // DeadlockInfo describes a potential deadlock
 type DeadlockInfo struct {
-	Type        string   `json:"type"`
-	Elements    []string `json:"elements"`
-	Description string   `json:"description"`
+	Type        string            `json:"type"`
+	Elements    []string          `json:"elements"`
+	Description string            `json:"description"`
+	Bounds      map[string]Bounds `json:"bounds,omitempty"`
 }
 
 // PathAnalysis contains path-related information
@@ -73,11 +108,69 @@ type AgentWorkloadAnalysis struct {
 	UnassignedTasks []string            `json:"unassigned_tasks"`
 }
 
+// SortedAgents returns the IDs of agents with assigned tasks in sorted order
+func (w AgentWorkloadAnalysis) SortedAgents() []string {
+	agents := make([]string, 0, len(w.AgentTasks))
+	for agent := range w.AgentTasks {
+		agents = append(agents, agent)
+	}
+	sort.Strings(agents)
+	return agents
+}
+
+// MetricThresholds defines upper limits for process metrics.
+// A zero value disables the check for that metric.
+type MetricThresholds struct {
+	MaxComplexity   int     `json:"max_complexity,omitempty"`
+	MaxDepth        int     `json:"max_depth,omitempty"`
+	MaxWidth        int     `json:"max_width,omitempty"`
+	MaxConnectivity float64 `json:"max_connectivity,omitempty"`
+}
+
+// IsZero reports whether no thresholds are set
+func (t MetricThresholds) IsZero() bool {
+	return t == MetricThresholds{}
+}
+
+// ThresholdViolation describes a metric that exceeds its threshold
+type ThresholdViolation struct {
+	Metric    string  `json:"metric"`
+	Value     float64 `json:"value"`
+	Threshold float64 `json:"threshold"`
+}
+
 // Analyzer performs graph analysis on BPMN processes
 type Analyzer struct {
-	process *Process
-	graph   map[string][]string // adjacency list representation
-	reverse map[string][]string // reverse adjacency list
+	process  *Process
+	graph    map[string][]string // adjacency list representation
+	reverse  map[string][]string // reverse adjacency list
+	dangling []string            // flows referencing undeclared elements
+	cache    analysisCache
+
+	requireHumanAgents bool // lint unassigned user and manual tasks as errors
+}
+
+// analysisCache holds analysis components computed since the last change to
+// the graph. A nil entry has not been computed yet or has been invalidated.
+type analysisCache struct {
+	reachability  *ReachabilityAnalysis
+	loops         *[]Loop
+	deadlocks     *[]DeadlockInfo
+	paths         *PathAnalysis
+	metrics       *ProcessMetrics
+	agentWorkload *AgentWorkloadAnalysis
+	lint          *[]LintWarning
+}
+
+// invalidateGraph drops every component that depends on sequence flows.
+// Agent workload only depends on activities and is kept.
+func (c *analysisCache) invalidateGraph() {
+	c.reachability = nil
+	c.loops = nil
+	c.deadlocks = nil
+	c.paths = nil
+	c.metrics = nil
+	c.lint = nil
 }
 
 // NewAnalyzer creates a new analyzer for a process
@@ -109,27 +202,216 @@ func (a *Analyzer) buildGraph() {
 
 	// Build adjacency lists from sequence flows
 	for _, flow := range a.process.ProcessInfo.Elements.SequenceFlows {
-		a.graph[flow.SourceRef] = append(a.graph[flow.SourceRef], flow.TargetRef)
-		a.reverse[flow.TargetRef] = append(a.reverse[flow.TargetRef], flow.SourceRef)
+		a.linkFlow(flow)
+	}
+}
+
+// linkFlow adds a sequence flow to the adjacency lists. Flows whose endpoints
+// are not declared events, activities or gateways are recorded as dangling.
+func (a *Analyzer) linkFlow(flow SequenceFlow) {
+	if !a.isDeclared(flow.SourceRef) || !a.isDeclared(flow.TargetRef) {
+		a.dangling = append(a.dangling, flow.ID)
+		return
+	}
+	a.graph[flow.SourceRef] = append(a.graph[flow.SourceRef], flow.TargetRef)
+	a.reverse[flow.TargetRef] = append(a.reverse[flow.TargetRef], flow.SourceRef)
+}
+
+// unlinkFlow removes a sequence flow previously added with linkFlow
+func (a *Analyzer) unlinkFlow(flow SequenceFlow) {
+	if !a.isDeclared(flow.SourceRef) || !a.isDeclared(flow.TargetRef) {
+		a.dangling = removeFirst(a.dangling, flow.ID)
+		return
 	}
+	a.graph[flow.SourceRef] = removeFirst(a.graph[flow.SourceRef], flow.TargetRef)
+	a.reverse[flow.TargetRef] = removeFirst(a.reverse[flow.TargetRef], flow.SourceRef)
+}
+
+func (a *Analyzer) isDeclared(id string) bool {
+	_, ok := a.graph[id]
+	return ok
 }
 
-// Analyze performs comprehensive graph analysis
+// UpdateFlow adds and removes sequence flows without rebuilding the graph.
+// Removed flows are matched by ID. The process passed to NewAnalyzer is
+// updated in place, and only cached analyses that depend on sequence flows
+// are recomputed by the next call to Analyze.
+func (a *Analyzer) UpdateFlow(added, removed []SequenceFlow) {
+	if len(added) == 0 && len(removed) == 0 {
+		return
+	}
+
+	flows := a.process.ProcessInfo.Elements.SequenceFlows
+	for _, r := range removed {
+		for i, f := range flows {
+			if f.ID == r.ID {
+				flows = append(flows[:i:i], flows[i+1:]...)
+				a.unlinkFlow(f)
+				break
+			}
+		}
+	}
+	for _, f := range added {
+		flows = append(flows, f)
+		a.linkFlow(f)
+	}
+	a.process.ProcessInfo.Elements.SequenceFlows = flows
+
+	a.cache.invalidateGraph()
+}
+
+// Analyze performs comprehensive graph analysis. Components are cached
+// between calls until the graph changes through UpdateFlow.
 func (a *Analyzer) Analyze() *AnalysisResult {
+	c := &a.cache
+	if c.reachability == nil {
+		r := a.analyzeReachability()
+		c.reachability = &r
+	}
+	if c.deadlocks == nil {
+		d := a.detectDeadlocks()
+		c.deadlocks = &d
+	}
+	if c.paths == nil {
+		p := a.analyzePaths()
+		c.paths = &p
+	}
+	if c.metrics == nil {
+		m := a.calculateMetrics()
+		c.metrics = &m
+	}
+	if c.agentWorkload == nil {
+		w := a.analyzeAgentWorkload()
+		c.agentWorkload = &w
+	}
+	if c.lint == nil {
+		l := a.LintProcess()
+		c.lint = &l
+	}
+
+	// Results are copies so that callers cannot modify the cache
 	return &AnalysisResult{
-		Reachability:  a.analyzeReachability(),
-		Deadlocks:     a.detectDeadlocks(),
-		Paths:         a.analyzePaths(),
-		Metrics:       a.calculateMetrics(),
-		AgentWorkload: a.analyzeAgentWorkload(),
+		Reachability:  c.reachability.clone(),
+		Deadlocks:     cloneDeadlocks(*c.deadlocks),
+		Paths:         c.paths.clone(),
+		Metrics:       *c.metrics,
+		AgentWorkload: c.agentWorkload.clone(),
+		DanglingFlows: append([]string(nil), a.dangling...),
+		LintWarnings:  cloneLintWarnings(*c.lint),
+		MessageFlowIssues: a.checkMessageFlows(),
+	}
+}
+
+func (r *ReachabilityAnalysis) clone() ReachabilityAnalysis {
+	c := *r
+	c.UnreachableElements = slices.Clone(r.UnreachableElements)
+	c.DeadEndElements = slices.Clone(r.DeadEndElements)
+	c.ReachableFromStart = maps.Clone(r.ReachableFromStart)
+	c.ReachesEnd = maps.Clone(r.ReachesEnd)
+	c.Bounds = maps.Clone(r.Bounds)
+	return c
+}
+
+func (p *PathAnalysis) clone() PathAnalysis {
+	c := *p
+	c.CriticalPath = slices.Clone(p.CriticalPath)
+	if p.AllPaths != nil {
+		c.AllPaths = make([][]string, len(p.AllPaths))
+		for i, path := range p.AllPaths {
+			c.AllPaths[i] = slices.Clone(path)
+		}
+	}
+	if p.Loops != nil {
+		c.Loops = make([]Loop, len(p.Loops))
+		for i, loop := range p.Loops {
+			c.Loops[i] = Loop{Elements: slices.Clone(loop.Elements), Type: loop.Type}
+		}
+	}
+	return c
+}
+
+func (w *AgentWorkloadAnalysis) clone() AgentWorkloadAnalysis {
+	c := *w
+	if w.AgentTasks != nil {
+		c.AgentTasks = make(map[string][]string, len(w.AgentTasks))
+		for agent, tasks := range w.AgentTasks {
+			c.AgentTasks[agent] = slices.Clone(tasks)
+		}
+	}
+	c.OverloadedAgents = slices.Clone(w.OverloadedAgents)
+	c.UnassignedTasks = slices.Clone(w.UnassignedTasks)
+	return c
+}
+
+func cloneDeadlocks(deadlocks []DeadlockInfo) []DeadlockInfo {
+	if deadlocks == nil {
+		return nil
+	}
+	c := make([]DeadlockInfo, len(deadlocks))
+	for i, d := range deadlocks {
+		d.Elements = slices.Clone(d.Elements)
+		d.Bounds = maps.Clone(d.Bounds)
+		c[i] = d
+	}
+	return c
+}
+
+func cloneLintWarnings(warnings []LintWarning) []LintWarning {
+	if warnings == nil {
+		return nil
 	}
+	c := make([]LintWarning, len(warnings))
+	for i, w := range warnings {
+		if w.Bounds != nil {
+			b := *w.Bounds
+			w.Bounds = &b
+		}
+		c[i] = w
+	}
+	return c
+}
+
+// AnalyzeWithThresholds performs analysis and checks metrics against thresholds
+func (a *Analyzer) AnalyzeWithThresholds(t MetricThresholds) *AnalysisResult {
+	result := a.Analyze()
+	result.Thresholds = &t
+	result.Violations = checkMetricThresholds(result.Metrics, t)
+	return result
+}
+
+// CheckThresholds returns the metrics that exceed the given thresholds
+func (a *Analyzer) CheckThresholds(t MetricThresholds) []ThresholdViolation {
+	return checkMetricThresholds(a.calculateMetrics(), t)
+}
+
+func checkMetricThresholds(metrics ProcessMetrics, t MetricThresholds) []ThresholdViolation {
+	var violations []ThresholdViolation
+
+	check := func(metric string, value, threshold float64) {
+		if threshold > 0 && value > threshold {
+			violations = append(violations, ThresholdViolation{
+				Metric:    metric,
+				Value:     value,
+				Threshold: threshold,
+			})
+		}
+	}
+
+	check("complexity", float64(metrics.Complexity), float64(t.MaxComplexity))
+	check("depth", float64(metrics.Depth), float64(t.MaxDepth))
+	check("width", float64(metrics.Width), float64(t.MaxWidth))
+	check("connectivity", metrics.Connectivity, t.MaxConnectivity)
+
+	return violations
 }
 
 // analyzeReachability checks element reachability
 func (a *Analyzer) analyzeReachability() ReachabilityAnalysis {
 	result := ReachabilityAnalysis{
-		ReachableFromStart: make(map[string]bool),
-		ReachesEnd:         make(map[string]bool),
+		UnreachableElements: []string{},
+		DeadEndElements:     []string{},
+		ReachableFromStart:  make(map[string]bool),
+		ReachesEnd:          make(map[string]bool),
 	}
 
 	// Find start and end events
@@ -153,7 +435,7 @@ func (a *Analyzer) analyzeReachability() ReachabilityAnalysis {
 	}
 
 	// Find unreachable and dead-end elements
-	for id := range a.graph {
+	for _, id := range a.sortedNodes() {
 		if !result.ReachableFromStart[id] {
 			result.UnreachableElements = append(result.UnreachableElements, id)
 		}
@@ -161,13 +443,14 @@ func (a *Analyzer) analyzeReachability() ReachabilityAnalysis {
 			result.DeadEndElements = append(result.DeadEndElements, id)
 		}
 	}
+	result.Bounds = a.boundsFor(append(append([]string{}, result.UnreachableElements...), result.DeadEndElements...))
 
 	return result
 }
 
 // detectDeadlocks identifies potential deadlocks
 func (a *Analyzer) detectDeadlocks() []DeadlockInfo {
-	var deadlocks []DeadlockInfo
+	deadlocks := []DeadlockInfo{}
 
 	// Check for missing join synchronization
 	for _, gateway := range a.process.ProcessInfo.Elements.Gateways {
@@ -198,8 +481,11 @@ func (a *Analyzer) detectDeadlocks() []DeadlockInfo {
 		}
 	}
 
+	// Check that parallel splits and their joins agree on the branch count
+	deadlocks = append(deadlocks, a.findUnbalancedParallelGateways()...)
+
 	// Check for exclusive gateway loops without exit conditions
-	loops := a.findLoops()
+	loops := a.cachedLoops()
 	for _, loop := range loops {
 		hasExit := false
 		for _, elem := range loop.Elements {
@@ -222,14 +508,109 @@ func (a *Analyzer) detectDeadlocks() []DeadlockInfo {
 		}
 	}
 
+	for i := range deadlocks {
+		deadlocks[i].Bounds = a.boundsFor(deadlocks[i].Elements)
+	}
+
 	return deadlocks
 }
 
+// findUnbalancedParallelGateways pairs each parallel split with the parallel
+// join its branches meet at, and flags pairs where the number of branches
+// leaving the split differs from the number reaching or expected by the join.
+// Nested split/join pairs inside a branch are skipped over while tracing.
+func (a *Analyzer) findUnbalancedParallelGateways() []DeadlockInfo {
+	var deadlocks []DeadlockInfo
+
+	for _, split := range a.process.ProcessInfo.Elements.Gateways {
+		branches := a.graph[split.ID]
+		if split.Type != "parallelGateway" || len(branches) < 2 || split.GatewayDirection == "converging" {
+			continue
+		}
+
+		// Count the branches reaching each join
+		reached := make(map[string]int)
+		for _, branch := range branches {
+			for join := range a.traceParallelJoins(branch) {
+				reached[join]++
+			}
+		}
+		if len(reached) == 0 {
+			continue
+		}
+
+		join := ""
+		for id, count := range reached {
+			if join == "" || count > reached[join] || (count == reached[join] && id < join) {
+				join = id
+			}
+		}
+		if join == split.ID {
+			continue
+		}
+
+		expected := len(a.reverse[join])
+		if reached[join] == len(branches) && expected == len(branches) {
+			continue
+		}
+		deadlocks = append(deadlocks, DeadlockInfo{
+			Type:     "unbalanced-parallel",
+			Elements: []string{split.ID, join},
+			Description: fmt.Sprintf("Parallel split '%s' has %d branches, but %d reach join '%s', which expects %d",
+				split.ID, len(branches), reached[join], join, expected),
+		})
+	}
+
+	return deadlocks
+}
+
+// traceParallelJoins follows flows from a split branch and returns the
+// parallel joins it reaches outside any nested split/join pair
+func (a *Analyzer) traceParallelJoins(start string) map[string]bool {
+	type state struct {
+		node  string
+		depth int
+	}
+
+	joins := make(map[string]bool)
+	visited := make(map[state]bool)
+	queue := []state{{start, 0}}
+
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+		if visited[current] || current.depth > len(a.graph) {
+			continue
+		}
+		visited[current] = true
+
+		depth := current.depth
+		if g := a.findGateway(current.node); g != nil && g.Type == "parallelGateway" {
+			if len(a.reverse[g.ID]) > 1 {
+				if depth == 0 {
+					joins[g.ID] = true
+					continue
+				}
+				depth--
+			}
+			if len(a.graph[g.ID]) > 1 {
+				depth++
+			}
+		}
+
+		for _, next := range a.graph[current.node] {
+			queue = append(queue, state{next, depth})
+		}
+	}
+
+	return joins
+}
+
 // analyzePaths analyzes all paths through the process
 func (a *Analyzer) analyzePaths() PathAnalysis {
 	result := PathAnalysis{
 		AllPaths: [][]string{},
-		Loops:    a.findLoops(),
+		Loops:    a.cachedLoops(),
 	}
 
 	startEvents := a.findStartEvents()
@@ -293,7 +674,7 @@ func (a *Analyzer) calculateMetrics() ProcessMetrics {
 	// Calculate complexity for BPMN processes
 	// Complexity = V + E - 2N (V=vertices, E=edges, N=connected components)
 	vertices := metrics.Elements.Total
-	edges := len(a.process.ProcessInfo.Elements.SequenceFlows)
+	edges := len(a.process.ProcessInfo.Elements.SequenceFlows) - len(a.dangling)
 	components := a.countConnectedComponents()
 	metrics.Complexity = vertices + edges - 2*components
 
@@ -312,7 +693,9 @@ func (a *Analyzer) calculateMetrics() ProcessMetrics {
 // analyzeAgentWorkload analyzes agent task distribution
 func (a *Analyzer) analyzeAgentWorkload() AgentWorkloadAnalysis {
 	result := AgentWorkloadAnalysis{
-		AgentTasks: make(map[string][]string),
+		AgentTasks:       make(map[string][]string),
+		OverloadedAgents: []string{},
+		UnassignedTasks:  []string{},
 	}
 
 	// Count tasks per agent
@@ -327,11 +710,22 @@ func (a *Analyzer) analyzeAgentWorkload() AgentWorkloadAnalysis {
 		}
 	}
 
+	// Iterate agents in sorted order so sums and output are deterministic
+	agents := make([]string, 0, len(agentCounts))
+	for agent := range agentCounts {
+		agents = append(agents, agent)
+	}
+	sort.Strings(agents)
+	for _, agent := range agents {
+		sort.Strings(result.AgentTasks[agent])
+	}
+
 	// Calculate workload balance (standard deviation)
 	if len(agentCounts) > 1 {
 		counts := make([]float64, 0, len(agentCounts))
 		total := 0.0
-		for _, count := range agentCounts {
+		for _, agent := range agents {
+			count := agentCounts[agent]
 			counts = append(counts, float64(count))
 			total += float64(count)
 		}
@@ -350,8 +744,8 @@ func (a *Analyzer) analyzeAgentWorkload() AgentWorkloadAnalysis {
 		}
 
 		// Identify overloaded agents (> 1.5x average)
-		for agent, count := range agentCounts {
-			if float64(count) > mean*1.5 {
+		for _, agent := range agents {
+			if float64(agentCounts[agent]) > mean*1.5 {
 				result.OverloadedAgents = append(result.OverloadedAgents, agent)
 			}
 		}
@@ -405,12 +799,21 @@ func (a *Analyzer) dfs(start string, graph map[string][]string) map[string]bool
 	return visited
 }
 
+// cachedLoops returns the loops in the graph, shared by deadlock and path analysis
+func (a *Analyzer) cachedLoops() []Loop {
+	if a.cache.loops == nil {
+		loops := a.findLoops()
+		a.cache.loops = &loops
+	}
+	return *a.cache.loops
+}
+
 func (a *Analyzer) findLoops() []Loop {
-	var loops []Loop
+	loops := []Loop{}
 	visited := make(map[string]bool)
 	recursionStack := make(map[string]bool)
 
-	for node := range a.graph {
+	for _, node := range a.sortedNodes() {
 		if !visited[node] {
 			a.findLoopsDFS(node, visited, recursionStack, []string{}, &loops)
 		}
@@ -485,22 +888,20 @@ func (a *Analyzer) countConnectedComponents() int {
 		undirected[node] = []string{}
 	}
 	
-	// Add edges in both directions
+	// Add edges in both directions. A self-loop connects nothing and would
+	// otherwise list the node twice in its own adjacency.
 	for source, targets := range a.graph {
 		for _, target := range targets {
+			if target == source {
+				continue
+			}
 			undirected[source] = append(undirected[source], target)
 			undirected[target] = append(undirected[target], source)
 		}
 	}
 
-	// Get all nodes in sorted order for deterministic results
-	nodes := make([]string, 0, len(undirected))
-	for node := range undirected {
-		nodes = append(nodes, node)
-	}
-	sort.Strings(nodes)
-
-	for _, node := range nodes {
+	// Visit nodes in sorted order for deterministic results
+	for _, node := range a.sortedNodes() {
 		if !visited[node] {
 			components++
 			componentNodes := a.dfs(node, undirected)
@@ -578,6 +979,16 @@ func (a *Analyzer) assignLevels(node string, level int, visited map[string]bool,
 	}
 }
 
+// sortedNodes returns the IDs of all declared elements in sorted order
+func (a *Analyzer) sortedNodes() []string {
+	nodes := make([]string, 0, len(a.graph))
+	for node := range a.graph {
+		nodes = append(nodes, node)
+	}
+	sort.Strings(nodes)
+	return nodes
+}
+
 func (a *Analyzer) findGateway(id string) *Gateway {
 	for _, g := range a.process.ProcessInfo.Elements.Gateways {
 		if g.ID == id {
@@ -587,6 +998,63 @@ func (a *Analyzer) findGateway(id string) *Gateway {
 	return nil
 }
 
+// elementBounds returns the diagram placement of an element, preferring an
+// activity's bounding box over its position. Elements placed by position
+// only report a zero width and height.
+func (a *Analyzer) elementBounds(id string) (Bounds, bool) {
+	elements := a.process.ProcessInfo.Elements
+	for _, act := range elements.Activities {
+		if act.ID != id {
+			continue
+		}
+		if act.Bounds != nil {
+			return *act.Bounds, true
+		}
+		if act.Position != nil {
+			return Bounds{X: act.Position.X, Y: act.Position.Y}, true
+		}
+		return Bounds{}, false
+	}
+	for _, e := range elements.Events {
+		if e.ID == id && e.Position != nil {
+			return Bounds{X: e.Position.X, Y: e.Position.Y}, true
+		}
+	}
+	for _, g := range elements.Gateways {
+		if g.ID == id && g.Position != nil {
+			return Bounds{X: g.Position.X, Y: g.Position.Y}, true
+		}
+	}
+	return Bounds{}, false
+}
+
+// boundsFor collects the diagram placement of the given elements, returning
+// nil when none of them carry diagram data
+func (a *Analyzer) boundsFor(ids []string) map[string]Bounds {
+	var result map[string]Bounds
+	for _, id := range ids {
+		b, ok := a.elementBounds(id)
+		if !ok {
+			continue
+		}
+		if result == nil {
+			result = make(map[string]Bounds)
+		}
+		result[id] = b
+	}
+	return result
+}
+
+// removeFirst removes the first occurrence of item, preserving order
+func removeFirst(slice []string, item string) []string {
+	for i, s := range slice {
+		if s == item {
+			return append(slice[:i:i], slice[i+1:]...)
+		}
+	}
+	return slice
+}
+
 func contains(slice []string, item string) bool {
 	for _, s := range slice {
 		if s == item {
@@ -633,6 +1101,13 @@ func FormatAnalysisReport(result *AnalysisResult) string {
 	} else {
 		report.WriteString("  ✓ All elements can reach an end event\n")
 	}
+
+	if len(result.DanglingFlows) > 0 {
+		report.WriteString("  ⚠️  Dangling Flows (reference undeclared elements):\n")
+		for _, flow := range result.DanglingFlows {
+			report.WriteString(fmt.Sprintf("    - %s\n", flow))
+		}
+	}
 	report.WriteString("\n")
 
 	// Deadlocks
@@ -675,8 +1150,8 @@ func FormatAnalysisReport(result *AnalysisResult) string {
 	report.WriteString("Agent Workload Analysis:\n")
 	if len(result.AgentWorkload.AgentTasks) > 0 {
 		report.WriteString("  Task Distribution:\n")
-		for agent, tasks := range result.AgentWorkload.AgentTasks {
-			report.WriteString(fmt.Sprintf("    - %s: %d tasks\n", agent, len(tasks)))
+		for _, agent := range result.AgentWorkload.SortedAgents() {
+			report.WriteString(fmt.Sprintf("    - %s: %d tasks\n", agent, len(result.AgentWorkload.AgentTasks[agent])))
 		}
 		report.WriteString(fmt.Sprintf("  Workload Balance Score: %.2f\n", result.AgentWorkload.WorkloadBalance))
 		
@@ -692,5 +1167,42 @@ func FormatAnalysisReport(result *AnalysisResult) string {
 		report.WriteString(fmt.Sprintf("  ⚠️  Unassigned Tasks: %d\n", len(result.AgentWorkload.UnassignedTasks)))
 	}
 
+	// Lint Warnings
+	if len(result.LintWarnings) > 0 {
+		report.WriteString("\nLint Warnings:\n")
+		for _, w := range result.LintWarnings {
+			marker := "⚠️ "
+			if w.IsError() {
+				marker = "✗"
+			}
+			if w.ElementName != "" {
+				report.WriteString(fmt.Sprintf("  %s %s (%s): %s\n", marker, w.ElementID, w.ElementName, w.Message))
+			} else {
+				report.WriteString(fmt.Sprintf("  %s %s: %s\n", marker, w.ElementID, w.Message))
+			}
+		}
+	}
+
+	// Message Flows
+	if len(result.MessageFlowIssues) > 0 {
+		report.WriteString("\nMessage Flow Issues:\n")
+		for _, issue := range result.MessageFlowIssues {
+			report.WriteString(fmt.Sprintf("  ✗ [%s] %s\n", issue.Type, issue.Message))
+		}
+	}
+
+	// Threshold Check
+	if result.Thresholds != nil {
+		report.WriteString("\nThreshold Check:\n")
+		if len(result.Violations) > 0 {
+			report.WriteString("  ✗ Metric Thresholds Exceeded:\n")
+			for _, v := range result.Violations {
+				report.WriteString(fmt.Sprintf("    - %s: %g (max %g)\n", v.Metric, v.Value, v.Threshold))
+			}
+		} else {
+			report.WriteString("  ✓ All metrics within thresholds\n")
+		}
+	}
+
 	return report.String()
 }
\ No newline at end of file
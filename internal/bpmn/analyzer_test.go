@@ -1,6 +1,10 @@
 package bpmn
 
 import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
 	"testing"
 )
 
@@ -307,4 +311,688 @@ func TestAnalyzerAgentWorkload(t *testing.T) {
 	if result.AgentWorkload.WorkloadBalance == 0 {
 		t.Error("Should have non-zero workload balance score indicating imbalance")
 	}
-}
\ No newline at end of file
+}
+func TestAnalyzerCheckThresholds(t *testing.T) {
+	// Parallel split/join: complexity 10, depth 5, width 2, connectivity 1.00
+	process := &Process{
+		ProcessInfo: ProcessInfo{
+			ID: "test_process",
+			Elements: Elements{
+				Events: []Event{
+					{ID: "start", Type: "startEvent"},
+					{ID: "end", Type: "endEvent"},
+				},
+				Activities: []Activity{
+					{ID: "task1", Type: "userTask"},
+					{ID: "task2", Type: "userTask"},
+				},
+				Gateways: []Gateway{
+					{ID: "split", Type: "parallelGateway", GatewayDirection: "diverging"},
+					{ID: "join", Type: "parallelGateway", GatewayDirection: "converging"},
+				},
+				SequenceFlows: []SequenceFlow{
+					{ID: "flow1", SourceRef: "start", TargetRef: "split"},
+					{ID: "flow2", SourceRef: "split", TargetRef: "task1"},
+					{ID: "flow3", SourceRef: "split", TargetRef: "task2"},
+					{ID: "flow4", SourceRef: "task1", TargetRef: "join"},
+					{ID: "flow5", SourceRef: "task2", TargetRef: "join"},
+					{ID: "flow6", SourceRef: "join", TargetRef: "end"},
+				},
+			},
+		},
+	}
+
+	tests := []struct {
+		name       string
+		thresholds MetricThresholds
+		violation  string
+	}{
+		{"complexity at limit", MetricThresholds{MaxComplexity: 10}, ""},
+		{"complexity above", MetricThresholds{MaxComplexity: 9}, "complexity"},
+		{"depth at limit", MetricThresholds{MaxDepth: 5}, ""},
+		{"depth above", MetricThresholds{MaxDepth: 4}, "depth"},
+		{"width at limit", MetricThresholds{MaxWidth: 2}, ""},
+		{"width above", MetricThresholds{MaxWidth: 1}, "width"},
+		{"connectivity below", MetricThresholds{MaxConnectivity: 1.01}, ""},
+		{"connectivity above", MetricThresholds{MaxConnectivity: 0.99}, "connectivity"},
+		{"no thresholds", MetricThresholds{}, ""},
+	}
+
+	analyzer := NewAnalyzer(process)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			violations := analyzer.CheckThresholds(tt.thresholds)
+			if tt.violation == "" {
+				if len(violations) != 0 {
+					t.Errorf("Expected no violations, got %v", violations)
+				}
+				return
+			}
+			if len(violations) != 1 || violations[0].Metric != tt.violation {
+				t.Errorf("Expected single %s violation, got %v", tt.violation, violations)
+			}
+		})
+	}
+}
+
+func TestAnalyzeWithThresholdsReport(t *testing.T) {
+	process := &Process{
+		ProcessInfo: ProcessInfo{
+			ID: "test_process",
+			Elements: Elements{
+				Events: []Event{
+					{ID: "start", Type: "startEvent"},
+					{ID: "end", Type: "endEvent"},
+				},
+				SequenceFlows: []SequenceFlow{
+					{ID: "flow1", SourceRef: "start", TargetRef: "end"},
+				},
+			},
+		},
+	}
+
+	analyzer := NewAnalyzer(process)
+
+	report := FormatAnalysisReport(analyzer.Analyze())
+	if strings.Contains(report, "Threshold Check") {
+		t.Error("Report should not include threshold section when no thresholds are provided")
+	}
+
+	result := analyzer.AnalyzeWithThresholds(MetricThresholds{MaxWidth: 1, MaxDepth: 1})
+	if len(result.Violations) != 1 {
+		t.Fatalf("Expected 1 violation, got %v", result.Violations)
+	}
+	report = FormatAnalysisReport(result)
+	if !strings.Contains(report, "depth: 2 (max 1)") {
+		t.Errorf("Report should list depth violation, got:\n%s", report)
+	}
+}
+
+func TestAnalyzerSingleComponent(t *testing.T) {
+	process := &Process{
+		ProcessInfo: ProcessInfo{
+			ID: "test_process",
+			Elements: Elements{
+				Events: []Event{
+					{ID: "start", Type: "startEvent"},
+					{ID: "end", Type: "endEvent"},
+				},
+				Activities: []Activity{
+					{ID: "task1", Type: "userTask"},
+					{ID: "task2", Type: "serviceTask"},
+				},
+				SequenceFlows: []SequenceFlow{
+					{ID: "flow1", SourceRef: "start", TargetRef: "task1"},
+					{ID: "flow2", SourceRef: "task1", TargetRef: "task2"},
+					{ID: "flow3", SourceRef: "task2", TargetRef: "end"},
+				},
+			},
+		},
+	}
+
+	analyzer := NewAnalyzer(process)
+	if components := analyzer.countConnectedComponents(); components != 1 {
+		t.Errorf("Single-path process should have 1 component, got %d", components)
+	}
+
+	result := analyzer.Analyze()
+	if len(result.DanglingFlows) != 0 {
+		t.Errorf("Should have no dangling flows, got %v", result.DanglingFlows)
+	}
+}
+
+func TestAnalyzerSelfLoop(t *testing.T) {
+	process := &Process{
+		ProcessInfo: ProcessInfo{
+			ID: "test_process",
+			Elements: Elements{
+				Events: []Event{
+					{ID: "start", Type: "startEvent"},
+					{ID: "end", Type: "endEvent"},
+				},
+				Activities: []Activity{
+					{ID: "task1", Type: "userTask"},
+				},
+				SequenceFlows: []SequenceFlow{
+					{ID: "flow1", SourceRef: "start", TargetRef: "task1"},
+					{ID: "flow2", SourceRef: "task1", TargetRef: "task1"}, // Retry
+					{ID: "flow3", SourceRef: "task1", TargetRef: "end"},
+				},
+			},
+		},
+	}
+
+	analyzer := NewAnalyzer(process)
+	if components := analyzer.countConnectedComponents(); components != 1 {
+		t.Errorf("Self-loop should not change the component count, got %d", components)
+	}
+
+	// The self-loop is one edge: Complexity = V + E - 2N = 3 + 3 - 2(1) = 4
+	if complexity := analyzer.Analyze().Metrics.Complexity; complexity != 4 {
+		t.Errorf("Complexity = %d, want 4", complexity)
+	}
+
+	// A task connected only to itself is a component of its own
+	process.ProcessInfo.Elements.Activities = append(process.ProcessInfo.Elements.Activities, Activity{ID: "task2", Type: "userTask"})
+	process.ProcessInfo.Elements.SequenceFlows = append(process.ProcessInfo.Elements.SequenceFlows,
+		SequenceFlow{ID: "flow4", SourceRef: "task2", TargetRef: "task2"})
+	if components := NewAnalyzer(process).countConnectedComponents(); components != 2 {
+		t.Errorf("Isolated self-looping task should be a separate component, got %d", components)
+	}
+}
+
+func TestAnalyzerDanglingFlow(t *testing.T) {
+	process := &Process{
+		ProcessInfo: ProcessInfo{
+			ID: "test_process",
+			Elements: Elements{
+				Events: []Event{
+					{ID: "start", Type: "startEvent"},
+					{ID: "end", Type: "endEvent"},
+				},
+				Activities: []Activity{
+					{ID: "task1", Type: "userTask"},
+				},
+				SequenceFlows: []SequenceFlow{
+					{ID: "flow1", SourceRef: "start", TargetRef: "task1"},
+					{ID: "flow2", SourceRef: "task1", TargetRef: "end"},
+					{ID: "flow3", SourceRef: "task1", TargetRef: "data_object"}, // Undeclared target
+				},
+			},
+		},
+	}
+
+	analyzer := NewAnalyzer(process)
+	if components := analyzer.countConnectedComponents(); components != 1 {
+		t.Errorf("Dangling flow should not add a component, got %d", components)
+	}
+
+	result := analyzer.Analyze()
+	if len(result.DanglingFlows) != 1 || result.DanglingFlows[0] != "flow3" {
+		t.Errorf("Should report flow3 as dangling, got %v", result.DanglingFlows)
+	}
+
+	if len(result.Reachability.UnreachableElements) != 0 {
+		t.Errorf("Dangling target should not appear as unreachable, got %v", result.Reachability.UnreachableElements)
+	}
+
+	// Complexity = V + E - 2N = 3 + 2 - 2(1) = 3
+	if result.Metrics.Complexity != 3 {
+		t.Errorf("Complexity should ignore dangling flows and be 3, got %d", result.Metrics.Complexity)
+	}
+
+	if !strings.Contains(FormatAnalysisReport(result), "Dangling Flows") {
+		t.Error("Report should include dangling flows")
+	}
+}
+
+func newIncrementalTestProcess() *Process {
+	return &Process{
+		ProcessInfo: ProcessInfo{
+			ID: "test_process",
+			Elements: Elements{
+				Events: []Event{
+					{ID: "start", Type: "startEvent"},
+					{ID: "end", Type: "endEvent"},
+				},
+				Activities: []Activity{
+					{ID: "task1", Type: "userTask", Agent: &AgentAssignment{Type: "human", ID: "alice"}},
+					{ID: "task2", Type: "userTask"},
+					{ID: "task3", Type: "serviceTask"},
+				},
+				Gateways: []Gateway{
+					{ID: "split", Type: "exclusiveGateway", GatewayDirection: "diverging"},
+				},
+				SequenceFlows: []SequenceFlow{
+					{ID: "flow1", SourceRef: "start", TargetRef: "task1"},
+					{ID: "flow2", SourceRef: "task1", TargetRef: "split"},
+					{ID: "flow3", SourceRef: "split", TargetRef: "task2"},
+					{ID: "flow4", SourceRef: "task2", TargetRef: "end"},
+					{ID: "flow5", SourceRef: "task3", TargetRef: "end"},
+					{ID: "flow6", SourceRef: "task3", TargetRef: "data_object"},
+				},
+			},
+		},
+	}
+}
+
+func TestAnalyzerUpdateFlowMatchesFreshAnalysis(t *testing.T) {
+	process := newIncrementalTestProcess()
+	analyzer := NewAnalyzer(process)
+	before := analyzer.Analyze()
+
+	if len(before.Reachability.UnreachableElements) != 1 || len(before.DanglingFlows) != 1 {
+		t.Fatalf("Unexpected initial analysis: unreachable %v, dangling %v",
+			before.Reachability.UnreachableElements, before.DanglingFlows)
+	}
+
+	// Connect task3 into the process, drop the dangling flow and add another
+	analyzer.UpdateFlow(
+		[]SequenceFlow{
+			{ID: "flow7", SourceRef: "split", TargetRef: "task3"},
+			{ID: "flow8", SourceRef: "task2", TargetRef: "archive"},
+		},
+		[]SequenceFlow{{ID: "flow6"}},
+	)
+	got := analyzer.Analyze()
+
+	want := NewAnalyzer(process).Analyze()
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Incremental analysis differs from fresh analysis:\ngot  %+v\nwant %+v", got, want)
+	}
+	if len(got.Reachability.UnreachableElements) != 0 {
+		t.Errorf("task3 should be reachable after update, got %v", got.Reachability.UnreachableElements)
+	}
+	if !reflect.DeepEqual(got.DanglingFlows, []string{"flow8"}) {
+		t.Errorf("DanglingFlows = %v, want [flow8]", got.DanglingFlows)
+	}
+	if len(got.LintWarnings) != 0 {
+		t.Errorf("split now has two branches, got lint warnings %v", got.LintWarnings)
+	}
+
+	// Removing the flows again restores the original analysis
+	analyzer.UpdateFlow(
+		[]SequenceFlow{{ID: "flow6", SourceRef: "task3", TargetRef: "data_object"}},
+		[]SequenceFlow{{ID: "flow7"}, {ID: "flow8"}},
+	)
+	restored := analyzer.Analyze()
+	if !reflect.DeepEqual(restored, before) {
+		t.Errorf("Analysis after reverting differs:\ngot  %+v\nwant %+v", restored, before)
+	}
+}
+
+func TestAnalyzerUpdateFlowInvalidatesCache(t *testing.T) {
+	analyzer := NewAnalyzer(newIncrementalTestProcess())
+	analyzer.Analyze()
+
+	workload := analyzer.cache.agentWorkload
+	if workload == nil || analyzer.cache.reachability == nil {
+		t.Fatal("Analyze should populate the cache")
+	}
+
+	analyzer.UpdateFlow(nil, nil)
+	if analyzer.cache.reachability == nil {
+		t.Error("An empty update should not invalidate the cache")
+	}
+
+	analyzer.UpdateFlow([]SequenceFlow{{ID: "flow7", SourceRef: "split", TargetRef: "task3"}}, nil)
+	if analyzer.cache.reachability != nil || analyzer.cache.paths != nil || analyzer.cache.metrics != nil {
+		t.Error("Flow changes should invalidate graph-dependent analyses")
+	}
+	if analyzer.cache.agentWorkload != workload {
+		t.Error("Flow changes should not invalidate agent workload")
+	}
+}
+
+func TestAnalyzerDeterministicOutput(t *testing.T) {
+	process := &Process{
+		ProcessInfo: ProcessInfo{
+			ID: "test_process",
+			Elements: Elements{
+				Events: []Event{
+					{ID: "start", Type: "startEvent"},
+					{ID: "end", Type: "endEvent"},
+				},
+				Activities: []Activity{
+					{ID: "task_e", Type: "userTask", Agent: &AgentAssignment{Type: "human", ID: "zoe"}},
+					{ID: "task_d", Type: "userTask", Agent: &AgentAssignment{Type: "human", ID: "zoe"}},
+					{ID: "task_c", Type: "userTask", Agent: &AgentAssignment{Type: "human", ID: "zoe"}},
+					{ID: "task_b", Type: "userTask", Agent: &AgentAssignment{Type: "ai", ID: "bot"}},
+					{ID: "task_a", Type: "userTask", Agent: &AgentAssignment{Type: "human", ID: "amy"}},
+					{ID: "orphan_1", Type: "userTask", Agent: &AgentAssignment{Type: "human", ID: "max"}},
+					{ID: "orphan_2", Type: "userTask"},
+				},
+				Gateways: []Gateway{
+					{ID: "retry", Type: "exclusiveGateway", GatewayDirection: "diverging"},
+				},
+				SequenceFlows: []SequenceFlow{
+					{ID: "flow1", SourceRef: "start", TargetRef: "task_a"},
+					{ID: "flow2", SourceRef: "task_a", TargetRef: "task_b"},
+					{ID: "flow3", SourceRef: "task_b", TargetRef: "retry"},
+					{ID: "flow4", SourceRef: "retry", TargetRef: "task_a"},
+					{ID: "flow5", SourceRef: "retry", TargetRef: "task_c"},
+					{ID: "flow6", SourceRef: "task_c", TargetRef: "task_d"},
+					{ID: "flow7", SourceRef: "task_d", TargetRef: "task_e"},
+					{ID: "flow8", SourceRef: "task_e", TargetRef: "end"},
+					{ID: "flow9", SourceRef: "orphan_1", TargetRef: "orphan_2"},
+				},
+			},
+		},
+	}
+
+	first := NewAnalyzer(process).Analyze()
+	if got := strings.Join(first.Reachability.UnreachableElements, ","); got != "orphan_1,orphan_2" {
+		t.Errorf("UnreachableElements = %s, want sorted orphan_1,orphan_2", got)
+	}
+	if got := strings.Join(first.AgentWorkload.AgentTasks["zoe"], ","); got != "task_c,task_d,task_e" {
+		t.Errorf("AgentTasks[zoe] = %s, want sorted task_c,task_d,task_e", got)
+	}
+
+	// Map iteration order is randomized, so repeat enough times to catch it
+	want := FormatAnalysisReport(first)
+	for i := 0; i < 20; i++ {
+		if got := FormatAnalysisReport(NewAnalyzer(process).Analyze()); got != want {
+			t.Fatalf("Report differs between runs:\n%s\n---\n%s", want, got)
+		}
+	}
+}
+
+func TestAnalysisResultJSON(t *testing.T) {
+	process := &Process{
+		ProcessInfo: ProcessInfo{
+			ID: "test_process",
+			Elements: Elements{
+				Events: []Event{
+					{ID: "start", Type: "startEvent"},
+					{ID: "end", Type: "endEvent"},
+				},
+				Activities: []Activity{
+					{ID: "task1", Type: "userTask", Agent: &AgentAssignment{Type: "human", ID: "alice"}},
+					{ID: "task_z", Type: "userTask"},
+					{ID: "task_y", Type: "userTask"},
+				},
+				SequenceFlows: []SequenceFlow{
+					{ID: "flow1", SourceRef: "start", TargetRef: "task1"},
+					{ID: "flow2", SourceRef: "task1", TargetRef: "end"},
+				},
+			},
+		},
+	}
+
+	result := NewAnalyzer(process).Analyze()
+	data, err := json.Marshal(result)
+	if err != nil {
+		t.Fatalf("Marshal() error = %v", err)
+	}
+
+	var decoded map[string]any
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatalf("Output is not valid JSON: %v", err)
+	}
+	for _, key := range []string{"reachability", "deadlocks", "paths", "metrics", "agent_workload"} {
+		if _, ok := decoded[key]; !ok {
+			t.Errorf("JSON missing %q", key)
+		}
+	}
+
+	var roundTrip AnalysisResult
+	if err := json.Unmarshal(data, &roundTrip); err != nil {
+		t.Fatalf("Unmarshal() error = %v", err)
+	}
+	if got := strings.Join(roundTrip.Reachability.UnreachableElements, ","); got != "task_y,task_z" {
+		t.Errorf("UnreachableElements = %s, want sorted task_y,task_z", got)
+	}
+	if !roundTrip.Reachability.ReachableFromStart["task1"] {
+		t.Error("Reachability map should round-trip")
+	}
+	if roundTrip.Deadlocks == nil {
+		t.Error("Deadlocks should serialize as an empty array, not null")
+	}
+
+	// Marshaling again yields identical bytes
+	again, _ := json.Marshal(NewAnalyzer(process).Analyze())
+	if string(again) != string(data) {
+		t.Error("JSON output is not deterministic")
+	}
+}
+
+func TestAnalysisResultStrictIssues(t *testing.T) {
+	clean := &AnalysisResult{}
+	if issues := clean.StrictIssues(); len(issues) != 0 {
+		t.Errorf("Expected no strict issues, got %v", issues)
+	}
+
+	result := &AnalysisResult{
+		Deadlocks:    []DeadlockInfo{{Type: "incomplete-join"}},
+		Reachability: ReachabilityAnalysis{UnreachableElements: []string{"task_y", "task_z"}},
+	}
+	issues := result.StrictIssues()
+	if len(issues) != 2 {
+		t.Fatalf("Expected 2 strict issues, got %v", issues)
+	}
+	if issues[0] != "1 potential deadlock(s)" || issues[1] != "2 unreachable element(s): task_y, task_z" {
+		t.Errorf("Unexpected issues: %v", issues)
+	}
+}
+
+func TestAnalyzerCarriesDiagramBounds(t *testing.T) {
+	data := `{
+		"$type": "bpmn:process",
+		"version": "2.0",
+		"process": {
+			"id": "di_process",
+			"name": "DI Process",
+			"elements": {
+				"events": [
+					{"id": "start", "type": "startEvent", "position": {"x": 10, "y": 100}},
+					{"id": "end", "type": "endEvent", "position": {"x": 600, "y": 100}}
+				],
+				"activities": [
+					{"id": "work", "name": "Work", "type": "task", "bounds": {"x": 200, "y": 40, "width": 100, "height": 80}},
+					{"id": "orphan", "name": "Orphan", "type": "task", "position": {"x": 200, "y": 300}},
+					{"id": "no_di", "name": "No DI", "type": "task"}
+				],
+				"gateways": [
+					{"id": "split", "type": "parallelGateway", "gatewayDirection": "diverging", "position": {"x": 100, "y": 100}},
+					{"id": "join", "type": "parallelGateway", "gatewayDirection": "converging", "position": {"x": 400, "y": 100}},
+					{"id": "pass", "type": "exclusiveGateway", "position": {"x": 500, "y": 100}}
+				],
+				"sequenceFlows": [
+					{"id": "f1", "sourceRef": "start", "targetRef": "split"},
+					{"id": "f2", "sourceRef": "split", "targetRef": "work"},
+					{"id": "f3", "sourceRef": "split", "targetRef": "join"},
+					{"id": "f4", "sourceRef": "work", "targetRef": "join"},
+					{"id": "f5", "sourceRef": "join", "targetRef": "pass"},
+					{"id": "f6", "sourceRef": "pass", "targetRef": "end"}
+				]
+			}
+		}
+	}`
+
+	var process Process
+	if err := json.Unmarshal([]byte(data), &process); err != nil {
+		t.Fatalf("Unmarshal() error = %v", err)
+	}
+	result := NewAnalyzer(&process).Analyze()
+
+	if len(result.Deadlocks) != 1 {
+		t.Fatalf("Expected 1 deadlock, got %+v", result.Deadlocks)
+	}
+	deadlock := result.Deadlocks[0]
+	if got := deadlock.Bounds["join"]; got != (Bounds{X: 400, Y: 100}) {
+		t.Errorf("Deadlock bounds for join = %+v", got)
+	}
+	if got := deadlock.Bounds["split"]; got != (Bounds{X: 100, Y: 100}) {
+		t.Errorf("Deadlock bounds for split = %+v", got)
+	}
+
+	if got := result.Reachability.Bounds["orphan"]; got != (Bounds{X: 200, Y: 300}) {
+		t.Errorf("Reachability bounds for orphan = %+v", got)
+	}
+	if _, ok := result.Reachability.Bounds["no_di"]; ok {
+		t.Error("Elements without diagram data should have no bounds")
+	}
+	if _, ok := result.Reachability.Bounds["work"]; ok {
+		t.Error("Only unreachable and dead-end elements should carry bounds")
+	}
+
+	if len(result.LintWarnings) != 1 || result.LintWarnings[0].Bounds == nil ||
+		*result.LintWarnings[0].Bounds != (Bounds{X: 500, Y: 100}) {
+		t.Errorf("Lint warnings = %+v, want pass gateway with its position", result.LintWarnings)
+	}
+
+	// Without diagram data the field is omitted entirely
+	plain := NewAnalyzer(newChainProcess(2)).Analyze()
+	out, _ := json.Marshal(plain)
+	if strings.Contains(string(out), `"bounds"`) {
+		t.Errorf("Expected no bounds in output without diagram data: %s", out)
+	}
+}
+
+// newChainProcess builds start -> task0 -> ... -> taskN-1 -> end
+func newChainProcess(n int) *Process {
+	p := &Process{
+		ProcessInfo: ProcessInfo{
+			ID: "chain",
+			Elements: Elements{
+				Events: []Event{
+					{ID: "start", Type: "startEvent"},
+					{ID: "end", Type: "endEvent"},
+				},
+			},
+		},
+	}
+	elems := &p.ProcessInfo.Elements
+	prev := "start"
+	for i := 0; i < n; i++ {
+		id := fmt.Sprintf("task%d", i)
+		elems.Activities = append(elems.Activities, Activity{ID: id, Type: "serviceTask"})
+		elems.SequenceFlows = append(elems.SequenceFlows, SequenceFlow{ID: "flow_" + id, SourceRef: prev, TargetRef: id})
+		prev = id
+	}
+	elems.SequenceFlows = append(elems.SequenceFlows, SequenceFlow{ID: "flow_end", SourceRef: prev, TargetRef: "end"})
+	return p
+}
+
+func BenchmarkAnalyzerRebuild(b *testing.B) {
+	process := newChainProcess(1000)
+	for i := 0; i < b.N; i++ {
+		NewAnalyzer(process)
+	}
+}
+
+func BenchmarkAnalyzerUpdateFlow(b *testing.B) {
+	analyzer := NewAnalyzer(newChainProcess(1000))
+	extra := SequenceFlow{ID: "extra", SourceRef: "task10", TargetRef: "task500"}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		analyzer.UpdateFlow([]SequenceFlow{extra}, nil)
+		analyzer.UpdateFlow(nil, []SequenceFlow{extra})
+	}
+}
+
+// newSplitJoinProcess builds start -> split -> branches -> join -> end, where
+// each branch is a task and extra flows are added as given
+func newSplitJoinProcess(branches []string, joined []string, extra ...SequenceFlow) *Process {
+	p := &Process{
+		ProcessInfo: ProcessInfo{
+			ID: "split_join",
+			Elements: Elements{
+				Events: []Event{{ID: "start", Type: "startEvent"}, {ID: "end", Type: "endEvent"}},
+				Gateways: []Gateway{
+					{ID: "split", Type: "parallelGateway", GatewayDirection: "diverging"},
+					{ID: "join", Type: "parallelGateway", GatewayDirection: "converging"},
+				},
+				SequenceFlows: []SequenceFlow{
+					{ID: "f_start", SourceRef: "start", TargetRef: "split"},
+					{ID: "f_end", SourceRef: "join", TargetRef: "end"},
+				},
+			},
+		},
+	}
+	for _, b := range branches {
+		p.ProcessInfo.Elements.Activities = append(p.ProcessInfo.Elements.Activities, Activity{ID: b, Name: b, Type: "task"})
+		p.ProcessInfo.Elements.SequenceFlows = append(p.ProcessInfo.Elements.SequenceFlows, SequenceFlow{ID: "f_split_" + b, SourceRef: "split", TargetRef: b})
+	}
+	for _, b := range joined {
+		p.ProcessInfo.Elements.SequenceFlows = append(p.ProcessInfo.Elements.SequenceFlows, SequenceFlow{ID: "f_join_" + b, SourceRef: b, TargetRef: "join"})
+	}
+	p.ProcessInfo.Elements.SequenceFlows = append(p.ProcessInfo.Elements.SequenceFlows, extra...)
+	return p
+}
+
+func unbalancedParallel(result *AnalysisResult) []DeadlockInfo {
+	var found []DeadlockInfo
+	for _, d := range result.Deadlocks {
+		if d.Type == "unbalanced-parallel" {
+			found = append(found, d)
+		}
+	}
+	return found
+}
+
+func TestAnalyzerBalancedParallelGateways(t *testing.T) {
+	p := newSplitJoinProcess([]string{"a", "b", "c"}, []string{"a", "b", "c"})
+	if found := unbalancedParallel(NewAnalyzer(p).Analyze()); len(found) != 0 {
+		t.Errorf("Expected balanced split/join, got %+v", found)
+	}
+}
+
+func TestAnalyzerUnbalancedParallelGateways(t *testing.T) {
+	// Three branches leave the split but only two reach the join; the third
+	// ends on its own
+	p := newSplitJoinProcess([]string{"a", "b", "c"}, []string{"a", "b"},
+		SequenceFlow{ID: "f_c_end", SourceRef: "c", TargetRef: "end"})
+
+	found := unbalancedParallel(NewAnalyzer(p).Analyze())
+	if len(found) != 1 {
+		t.Fatalf("Expected 1 unbalanced split/join, got %+v", found)
+	}
+	if strings.Join(found[0].Elements, ",") != "split,join" {
+		t.Errorf("Elements = %v, want [split join]", found[0].Elements)
+	}
+	if !strings.Contains(found[0].Description, "3 branches, but 2 reach join 'join', which expects 2") {
+		t.Errorf("Unexpected description: %s", found[0].Description)
+	}
+}
+
+func TestAnalyzerJoinExpectsExtraBranch(t *testing.T) {
+	// The join also waits on a flow that never carries a token from the split
+	p := newSplitJoinProcess([]string{"a", "b"}, []string{"a", "b"})
+	p.ProcessInfo.Elements.Events = append(p.ProcessInfo.Elements.Events, Event{ID: "other_start", Type: "startEvent"})
+	p.ProcessInfo.Elements.SequenceFlows = append(p.ProcessInfo.Elements.SequenceFlows,
+		SequenceFlow{ID: "f_other", SourceRef: "other_start", TargetRef: "join"})
+
+	found := unbalancedParallel(NewAnalyzer(p).Analyze())
+	if len(found) != 1 || !strings.Contains(found[0].Description, "which expects 3") {
+		t.Errorf("Expected join expecting 3 branches to be flagged, got %+v", found)
+	}
+}
+
+func TestAnalyzerNestedParallelGateways(t *testing.T) {
+	// Branch a holds its own balanced split/join before reaching the outer join
+	p := newSplitJoinProcess([]string{"a", "b"}, []string{"b"},
+		SequenceFlow{ID: "n1", SourceRef: "a", TargetRef: "inner_split"},
+		SequenceFlow{ID: "n2", SourceRef: "inner_split", TargetRef: "x"},
+		SequenceFlow{ID: "n3", SourceRef: "inner_split", TargetRef: "y"},
+		SequenceFlow{ID: "n4", SourceRef: "x", TargetRef: "inner_join"},
+		SequenceFlow{ID: "n5", SourceRef: "y", TargetRef: "inner_join"},
+		SequenceFlow{ID: "n6", SourceRef: "inner_join", TargetRef: "join"},
+	)
+	p.ProcessInfo.Elements.Gateways = append(p.ProcessInfo.Elements.Gateways,
+		Gateway{ID: "inner_split", Type: "parallelGateway", GatewayDirection: "diverging"},
+		Gateway{ID: "inner_join", Type: "parallelGateway", GatewayDirection: "converging"},
+	)
+	p.ProcessInfo.Elements.Activities = append(p.ProcessInfo.Elements.Activities,
+		Activity{ID: "x", Name: "x", Type: "task"}, Activity{ID: "y", Name: "y", Type: "task"})
+
+	if found := unbalancedParallel(NewAnalyzer(p).Analyze()); len(found) != 0 {
+		t.Errorf("Expected nested split/join pairs to balance, got %+v", found)
+	}
+}
+
+func TestAnalyzeResultsDoNotShareCache(t *testing.T) {
+	analyzer := NewAnalyzer(newIncrementalTestProcess())
+	want := NewAnalyzer(newIncrementalTestProcess()).Analyze()
+
+	// Scribble over every slice and map in a result
+	got := analyzer.Analyze()
+	got.Reachability.UnreachableElements[0] = "changed"
+	got.Reachability.ReachableFromStart["changed"] = true
+	got.Reachability.ReachesEnd["start"] = false
+	for i := range got.Deadlocks {
+		got.Deadlocks[i].Elements = append(got.Deadlocks[i].Elements[:0], "changed")
+	}
+	got.Paths.CriticalPath[0] = "changed"
+	for _, path := range got.Paths.AllPaths {
+		path[0] = "changed"
+	}
+	got.AgentWorkload.AgentTasks["alice"][0] = "changed"
+	got.AgentWorkload.UnassignedTasks[0] = "changed"
+	got.LintWarnings = append(got.LintWarnings[:0], LintWarning{Type: "changed"})
+
+	if again := analyzer.Analyze(); !reflect.DeepEqual(again, want) {
+		t.Errorf("Modifying a result changed later analyses:\ngot  %+v\nwant %+v", again, want)
+	}
+}
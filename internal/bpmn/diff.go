@@ -0,0 +1,187 @@
+package bpmn
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// FieldChange describes a single field that differs between two versions of an element
+type FieldChange struct {
+	Field string `json:"field"`
+	Old   string `json:"old"`
+	New   string `json:"new"`
+}
+
+// ElementDiff describes an element that was added, removed or modified
+type ElementDiff struct {
+	Kind    string        `json:"kind"` // "event", "activity", "gateway", "flow"
+	ID      string        `json:"id"`
+	Type    string        `json:"type,omitempty"`
+	Name    string        `json:"name,omitempty"`
+	Changes []FieldChange `json:"changes,omitempty"`
+}
+
+// ProcessDiff describes the differences between two versions of a process
+type ProcessDiff struct {
+	Added    []ElementDiff `json:"added"`
+	Removed  []ElementDiff `json:"removed"`
+	Modified []ElementDiff `json:"modified"`
+}
+
+// IsEmpty reports whether the two processes are equivalent
+func (d ProcessDiff) IsEmpty() bool {
+	return len(d.Added) == 0 && len(d.Removed) == 0 && len(d.Modified) == 0
+}
+
+// diffElement is the comparable view of an element
+type diffElement struct {
+	kind   string
+	typ    string
+	name   string
+	fields []FieldChange // Field and New hold the name and value
+}
+
+var diffKindOrder = map[string]int{"event": 0, "activity": 1, "gateway": 2, "flow": 3}
+
+// DiffProcesses compares two versions of a process by element ID. An ID that
+// is reused with a different kind or type is reported as a removal and an addition.
+func DiffProcesses(old, new *Process) ProcessDiff {
+	oldElems := diffElements(old)
+	newElems := diffElements(new)
+
+	diff := ProcessDiff{
+		Added:    []ElementDiff{},
+		Removed:  []ElementDiff{},
+		Modified: []ElementDiff{},
+	}
+
+	for id, o := range oldElems {
+		n, ok := newElems[id]
+		if !ok || n.kind != o.kind || n.typ != o.typ {
+			diff.Removed = append(diff.Removed, ElementDiff{Kind: o.kind, ID: id, Type: o.typ, Name: o.name})
+			continue
+		}
+
+		var changes []FieldChange
+		for i, f := range o.fields {
+			if f.New != n.fields[i].New {
+				changes = append(changes, FieldChange{Field: f.Field, Old: f.New, New: n.fields[i].New})
+			}
+		}
+		if len(changes) > 0 {
+			diff.Modified = append(diff.Modified, ElementDiff{Kind: n.kind, ID: id, Type: n.typ, Name: n.name, Changes: changes})
+		}
+	}
+
+	for id, n := range newElems {
+		o, ok := oldElems[id]
+		if !ok || n.kind != o.kind || n.typ != o.typ {
+			diff.Added = append(diff.Added, ElementDiff{Kind: n.kind, ID: id, Type: n.typ, Name: n.name})
+		}
+	}
+
+	sortElementDiffs(diff.Added)
+	sortElementDiffs(diff.Removed)
+	sortElementDiffs(diff.Modified)
+
+	return diff
+}
+
+// diffElements flattens the events, activities, gateways and flows of a
+// process into comparable elements keyed by ID
+func diffElements(p *Process) map[string]diffElement {
+	elems := make(map[string]diffElement)
+	field := func(name, value string) FieldChange {
+		return FieldChange{Field: name, New: value}
+	}
+
+	for _, e := range p.ProcessInfo.Elements.Events {
+		elems[e.ID] = diffElement{kind: "event", typ: e.Type, name: e.Name, fields: []FieldChange{
+			field("name", e.Name),
+			field("eventType", e.EventType),
+			field("attachedTo", e.AttachedTo),
+		}}
+	}
+	for _, act := range p.ProcessInfo.Elements.Activities {
+		elems[act.ID] = diffElement{kind: "activity", typ: act.Type, name: act.Name, fields: []FieldChange{
+			field("name", act.Name),
+			field("agent", formatDiffAgent(act.Agent)),
+			field("calledElement", act.CalledElement),
+		}}
+	}
+	for _, g := range p.ProcessInfo.Elements.Gateways {
+		elems[g.ID] = diffElement{kind: "gateway", typ: g.Type, name: g.Name, fields: []FieldChange{
+			field("name", g.Name),
+			field("direction", g.GatewayDirection),
+			field("defaultFlow", g.DefaultFlow),
+		}}
+	}
+	for _, f := range p.ProcessInfo.Elements.SequenceFlows {
+		condition := ""
+		if f.ConditionExpression != nil {
+			condition = f.ConditionExpression.Body
+		}
+		elems[f.ID] = diffElement{kind: "flow", typ: "sequenceFlow", name: f.Name, fields: []FieldChange{
+			field("name", f.Name),
+			field("source", f.SourceRef),
+			field("target", f.TargetRef),
+			field("condition", condition),
+			field("default", fmt.Sprintf("%t", f.IsDefault)),
+		}}
+	}
+
+	return elems
+}
+
+func formatDiffAgent(agent *AgentAssignment) string {
+	if agent == nil {
+		return ""
+	}
+	if agent.ID != "" {
+		return fmt.Sprintf("%s:%s", agent.Type, agent.ID)
+	}
+	return agent.Type
+}
+
+func sortElementDiffs(diffs []ElementDiff) {
+	sort.Slice(diffs, func(i, j int) bool {
+		if diffs[i].Kind != diffs[j].Kind {
+			return diffKindOrder[diffs[i].Kind] < diffKindOrder[diffs[j].Kind]
+		}
+		return diffs[i].ID < diffs[j].ID
+	})
+}
+
+// FormatProcessDiff creates a human-readable summary of a process diff
+func FormatProcessDiff(d ProcessDiff) string {
+	if d.IsEmpty() {
+		return "No differences\n"
+	}
+
+	var out strings.Builder
+	out.WriteString(fmt.Sprintf("%d added, %d removed, %d modified\n", len(d.Added), len(d.Removed), len(d.Modified)))
+
+	writeSection := func(title, prefix string, diffs []ElementDiff) {
+		if len(diffs) == 0 {
+			return
+		}
+		out.WriteString(fmt.Sprintf("\n%s:\n", title))
+		for _, e := range diffs {
+			label := fmt.Sprintf("%s %s (%s)", e.Kind, e.ID, e.Type)
+			if e.Name != "" {
+				label = fmt.Sprintf("%s %s '%s' (%s)", e.Kind, e.ID, e.Name, e.Type)
+			}
+			out.WriteString(fmt.Sprintf("  %s %s\n", prefix, label))
+			for _, c := range e.Changes {
+				out.WriteString(fmt.Sprintf("      %s: %q -> %q\n", c.Field, c.Old, c.New))
+			}
+		}
+	}
+
+	writeSection("Added", "+", d.Added)
+	writeSection("Removed", "-", d.Removed)
+	writeSection("Modified", "~", d.Modified)
+
+	return out.String()
+}
@@ -0,0 +1,116 @@
+package bpmn
+
+import (
+	"strings"
+	"testing"
+)
+
+func newDiffBaseProcess() *Process {
+	return &Process{
+		ProcessInfo: ProcessInfo{
+			ID: "test_process",
+			Elements: Elements{
+				Events: []Event{
+					{ID: "start", Type: "startEvent"},
+					{ID: "end", Type: "endEvent"},
+				},
+				Activities: []Activity{
+					{ID: "review", Name: "Review", Type: "userTask", Agent: &AgentAssignment{Type: "human", ID: "alice"}},
+				},
+				Gateways: []Gateway{
+					{ID: "decide", Name: "Approved?", Type: "exclusiveGateway", GatewayDirection: "diverging"},
+				},
+				SequenceFlows: []SequenceFlow{
+					{ID: "flow1", SourceRef: "start", TargetRef: "review"},
+					{ID: "flow2", SourceRef: "review", TargetRef: "decide"},
+					{ID: "flow3", SourceRef: "decide", TargetRef: "end"},
+				},
+			},
+		},
+	}
+}
+
+func TestDiffProcessesIdentical(t *testing.T) {
+	diff := DiffProcesses(newDiffBaseProcess(), newDiffBaseProcess())
+	if !diff.IsEmpty() {
+		t.Errorf("Expected empty diff, got %+v", diff)
+	}
+	if FormatProcessDiff(diff) != "No differences\n" {
+		t.Errorf("Unexpected output: %q", FormatProcessDiff(diff))
+	}
+}
+
+func TestDiffProcessesAddedActivityAndRenamedGateway(t *testing.T) {
+	updated := newDiffBaseProcess()
+	elems := &updated.ProcessInfo.Elements
+	elems.Activities = append(elems.Activities, Activity{ID: "notify", Name: "Notify", Type: "serviceTask"})
+	elems.Gateways[0].Name = "Is approved?"
+	elems.SequenceFlows[2] = SequenceFlow{ID: "flow3", SourceRef: "decide", TargetRef: "notify"}
+	elems.SequenceFlows = append(elems.SequenceFlows, SequenceFlow{ID: "flow4", SourceRef: "notify", TargetRef: "end"})
+
+	diff := DiffProcesses(newDiffBaseProcess(), updated)
+
+	if len(diff.Removed) != 0 {
+		t.Errorf("Expected no removals, got %+v", diff.Removed)
+	}
+	if len(diff.Added) != 2 || diff.Added[0].ID != "notify" || diff.Added[1].ID != "flow4" {
+		t.Fatalf("Added = %+v, want notify then flow4", diff.Added)
+	}
+	if len(diff.Modified) != 2 {
+		t.Fatalf("Modified = %+v, want decide and flow3", diff.Modified)
+	}
+
+	gw := diff.Modified[0]
+	if gw.ID != "decide" || len(gw.Changes) != 1 {
+		t.Fatalf("Unexpected gateway diff: %+v", gw)
+	}
+	if gw.Changes[0] != (FieldChange{Field: "name", Old: "Approved?", New: "Is approved?"}) {
+		t.Errorf("Unexpected gateway change: %+v", gw.Changes[0])
+	}
+
+	flow := diff.Modified[1]
+	if flow.ID != "flow3" || len(flow.Changes) != 1 || flow.Changes[0].Field != "target" {
+		t.Errorf("Unexpected flow diff: %+v", flow)
+	}
+
+	out := FormatProcessDiff(diff)
+	for _, want := range []string{
+		"2 added, 0 removed, 2 modified",
+		"+ activity notify 'Notify' (serviceTask)",
+		"~ gateway decide 'Is approved?' (exclusiveGateway)",
+		`name: "Approved?" -> "Is approved?"`,
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("Output missing %q:\n%s", want, out)
+		}
+	}
+}
+
+func TestDiffProcessesAgentChange(t *testing.T) {
+	updated := newDiffBaseProcess()
+	updated.ProcessInfo.Elements.Activities[0].Agent = &AgentAssignment{Type: "ai", ID: "reviewer-bot"}
+
+	diff := DiffProcesses(newDiffBaseProcess(), updated)
+	if len(diff.Modified) != 1 || len(diff.Modified[0].Changes) != 1 {
+		t.Fatalf("Modified = %+v", diff.Modified)
+	}
+	if diff.Modified[0].Changes[0] != (FieldChange{Field: "agent", Old: "human:alice", New: "ai:reviewer-bot"}) {
+		t.Errorf("Unexpected agent change: %+v", diff.Modified[0].Changes[0])
+	}
+}
+
+func TestDiffProcessesTypeChangeIsRemoveAndAdd(t *testing.T) {
+	updated := newDiffBaseProcess()
+	updated.ProcessInfo.Elements.Activities[0].Type = "serviceTask"
+
+	diff := DiffProcesses(newDiffBaseProcess(), updated)
+	if len(diff.Modified) != 0 {
+		t.Errorf("Expected no modifications, got %+v", diff.Modified)
+	}
+	if len(diff.Removed) != 1 || diff.Removed[0].Type != "userTask" {
+		t.Errorf("Removed = %+v, want review userTask", diff.Removed)
+	}
+	if len(diff.Added) != 1 || diff.Added[0].Type != "serviceTask" {
+		t.Errorf("Added = %+v, want review serviceTask", diff.Added)
+	}
+}
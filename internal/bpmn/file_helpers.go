@@ -34,7 +34,12 @@ func (v *FileValidator) ValidateFile(filePath string) (*ValidationResult, error)
 }
 
 // FileAnalyzer provides file-based analysis
-type FileAnalyzer struct{}
+type FileAnalyzer struct {
+	// Thresholds, when set, are checked against the process metrics
+	Thresholds *MetricThresholds
+	// RequireHumanAgents reports unassigned user and manual tasks as lint errors
+	RequireHumanAgents bool
+}
 
 // AnalyzeFile analyzes a BPMN file
 func (a *FileAnalyzer) AnalyzeFile(filePath string) (*AnalysisResult, error) {
@@ -52,11 +57,80 @@ func (a *FileAnalyzer) AnalyzeFile(filePath string) (*AnalysisResult, error) {
 	
 	// Create analyzer with the process
 	analyzer := NewAnalyzer(&process)
+	analyzer.SetRequireHumanAgents(a.RequireHumanAgents)
 	
 	// Analyze
+	if a.Thresholds != nil {
+		return analyzer.AnalyzeWithThresholds(*a.Thresholds), nil
+	}
 	return analyzer.Analyze(), nil
 }
 
+// AnalyzeFiles analyzes a set of BPMN files together, tracing call
+// activities across them
+func (a *FileAnalyzer) AnalyzeFiles(filePaths []string) (*MultiProcessResult, error) {
+	var processes []*Process
+	for _, filePath := range filePaths {
+		data, err := ioutil.ReadFile(filePath)
+		if err != nil {
+			return nil, fmt.Errorf("reading file %s: %w", filePath, err)
+		}
+		
+		var process Process
+		if err := json.Unmarshal(data, &process); err != nil {
+			return nil, fmt.Errorf("parsing JSON in %s: %w", filePath, err)
+		}
+		processes = append(processes, &process)
+	}
+	
+	analyzer, err := NewMultiProcessAnalyzer(processes...)
+	if err != nil {
+		return nil, err
+	}
+	analyzer.SetRequireHumanAgents(a.RequireHumanAgents)
+	
+	if a.Thresholds != nil {
+		return analyzer.AnalyzeWithThresholds(*a.Thresholds), nil
+	}
+	return analyzer.Analyze(), nil
+}
+
+// FileSimulator provides file-based token simulation
+type FileSimulator struct {
+	Decisions map[string]string
+}
+
+// SimulateFile plays tokens through a BPMN file
+func (s *FileSimulator) SimulateFile(filePath string) (*SimulationResult, error) {
+	renderer := &FileRenderer{}
+	process, err := renderer.loadProcess(filePath)
+	if err != nil {
+		return nil, err
+	}
+	
+	return NewAnalyzer(process).Simulate(s.Decisions)
+}
+
+// FileDiffer provides file-based process comparison
+type FileDiffer struct{}
+
+// DiffFiles compares two versions of a BPMN file
+func (d *FileDiffer) DiffFiles(oldPath, newPath string) (ProcessDiff, error) {
+	renderer := &FileRenderer{}
+	
+	oldProcess, err := renderer.loadProcess(oldPath)
+	if err != nil {
+		return ProcessDiff{}, fmt.Errorf("loading %s: %w", oldPath, err)
+	}
+	
+	newProcess, err := renderer.loadProcess(newPath)
+	if err != nil {
+		return ProcessDiff{}, fmt.Errorf("loading %s: %w", newPath, err)
+	}
+	
+	return DiffProcesses(oldProcess, newProcess), nil
+}
+
 // FileRenderer provides file-based rendering
 type FileRenderer struct{}
 
@@ -78,9 +152,7 @@ func (r *FileRenderer) RenderMermaidFile(filePath string) (string, error) {
 		return "", err
 	}
 	
-	renderer := NewRenderer(process)
-	// Mermaid is rendered as DOT for now
-	return renderer.Render(FormatDOT)
+	return ProcessToMermaid(process), nil
 }
 
 // RenderTextFile renders a BPMN file to text format
@@ -0,0 +1,115 @@
+package bpmn
+
+import "fmt"
+
+// LintWarning describes a likely modeling mistake that does not make the
+// process invalid
+type LintWarning struct {
+	Type        string  `json:"type"`               // "single-branch-gateway", "unassigned-human-task"
+	Severity    string  `json:"severity,omitempty"` // empty for warnings, "error" for errors
+	ElementID   string  `json:"element_id"`
+	ElementName string  `json:"element_name,omitempty"`
+	Message     string  `json:"message"`
+	Bounds      *Bounds `json:"bounds,omitempty"`
+}
+
+// LintSeverityError marks lint findings that fail strict analysis
+const LintSeverityError = "error"
+
+// IsError reports whether the finding is an error rather than a warning
+func (w LintWarning) IsError() bool {
+	return w.Severity == LintSeverityError
+}
+
+// SetRequireHumanAgents enables the lint rule that reports user and manual
+// tasks without an assigned agent as errors
+func (a *Analyzer) SetRequireHumanAgents(require bool) {
+	a.requireHumanAgents = require
+	a.cache.lint = nil
+}
+
+// LintProcess checks the process for modeling smells
+func (a *Analyzer) LintProcess() []LintWarning {
+	var warnings []LintWarning
+	warnings = append(warnings, a.lintSingleBranchGateways()...)
+	if a.requireHumanAgents {
+		warnings = append(warnings, a.lintUnassignedHumanTasks()...)
+	}
+
+	for i := range warnings {
+		if b, ok := a.elementBounds(warnings[i].ElementID); ok {
+			warnings[i].Bounds = &b
+		}
+	}
+	return warnings
+}
+
+// lintSingleBranchGateways flags exclusive and parallel gateways that split
+// into or join a single branch. Mixed gateways, and gateways without a
+// direction, are flagged when they have exactly one incoming and one outgoing
+// flow.
+func (a *Analyzer) lintSingleBranchGateways() []LintWarning {
+	var warnings []LintWarning
+
+	for _, g := range a.process.ProcessInfo.Elements.Gateways {
+		if g.Type != "exclusiveGateway" && g.Type != "parallelGateway" {
+			continue
+		}
+
+		incoming := len(a.reverse[g.ID])
+		outgoing := len(a.graph[g.ID])
+
+		var message string
+		switch g.GatewayDirection {
+		case "diverging":
+			if outgoing == 1 {
+				message = fmt.Sprintf("Diverging %s '%s' has only one outgoing flow", g.Type, g.ID)
+			}
+		case "converging":
+			if incoming == 1 {
+				message = fmt.Sprintf("Converging %s '%s' has only one incoming flow", g.Type, g.ID)
+			}
+		case "", "mixed":
+			if incoming == 1 && outgoing == 1 {
+				message = fmt.Sprintf("%s '%s' has a single incoming and outgoing flow", g.Type, g.ID)
+			}
+		}
+
+		if message != "" {
+			warnings = append(warnings, LintWarning{
+				Type:        "single-branch-gateway",
+				ElementID:   g.ID,
+				ElementName: g.Name,
+				Message:     message,
+			})
+		}
+	}
+
+	return warnings
+}
+
+// lintUnassignedHumanTasks flags user and manual tasks without an assigned
+// agent. These need a human to carry them out; automated tasks are skipped.
+// An agent counts as assigned when it names an agent ID or role, or carries
+// rules for picking one at runtime.
+func (a *Analyzer) lintUnassignedHumanTasks() []LintWarning {
+	var warnings []LintWarning
+
+	for _, act := range a.process.ProcessInfo.Elements.Activities {
+		if act.Type != "userTask" && act.Type != "manualTask" {
+			continue
+		}
+		if agent := act.Agent; agent != nil && (agent.ID != "" || agent.Role != "" || len(agent.AssignmentRules) > 0) {
+			continue
+		}
+		warnings = append(warnings, LintWarning{
+			Type:        "unassigned-human-task",
+			Severity:    LintSeverityError,
+			ElementID:   act.ID,
+			ElementName: act.Name,
+			Message:     fmt.Sprintf("%s '%s' has no assigned agent", act.Type, act.ID),
+		})
+	}
+
+	return warnings
+}
@@ -0,0 +1,211 @@
+package bpmn
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestLintSingleBranchGateways(t *testing.T) {
+	tests := []struct {
+		name    string
+		gateway Gateway
+		flows   []SequenceFlow
+		want    bool
+	}{
+		{
+			name:    "diverging with one outgoing",
+			gateway: Gateway{ID: "gw", Name: "Decide", Type: "exclusiveGateway", GatewayDirection: "diverging"},
+			flows: []SequenceFlow{
+				{ID: "f1", SourceRef: "start", TargetRef: "gw"},
+				{ID: "f2", SourceRef: "gw", TargetRef: "task1"},
+				{ID: "f3", SourceRef: "task1", TargetRef: "end"},
+			},
+			want: true,
+		},
+		{
+			name:    "converging with one incoming",
+			gateway: Gateway{ID: "gw", Name: "Merge", Type: "parallelGateway", GatewayDirection: "converging"},
+			flows: []SequenceFlow{
+				{ID: "f1", SourceRef: "start", TargetRef: "task1"},
+				{ID: "f2", SourceRef: "task1", TargetRef: "gw"},
+				{ID: "f3", SourceRef: "gw", TargetRef: "end"},
+			},
+			want: true,
+		},
+		{
+			name:    "no direction pass-through",
+			gateway: Gateway{ID: "gw", Type: "exclusiveGateway"},
+			flows: []SequenceFlow{
+				{ID: "f1", SourceRef: "start", TargetRef: "gw"},
+				{ID: "f2", SourceRef: "gw", TargetRef: "task1"},
+				{ID: "f3", SourceRef: "task1", TargetRef: "end"},
+			},
+			want: true,
+		},
+		{
+			name:    "mixed pass-through",
+			gateway: Gateway{ID: "gw", Name: "Route", Type: "parallelGateway", GatewayDirection: "mixed"},
+			flows: []SequenceFlow{
+				{ID: "f1", SourceRef: "start", TargetRef: "gw"},
+				{ID: "f2", SourceRef: "gw", TargetRef: "task1"},
+				{ID: "f3", SourceRef: "task1", TargetRef: "end"},
+			},
+			want: true,
+		},
+		{
+			name:    "mixed with two outgoing",
+			gateway: Gateway{ID: "gw", Type: "exclusiveGateway", GatewayDirection: "mixed"},
+			flows: []SequenceFlow{
+				{ID: "f1", SourceRef: "start", TargetRef: "gw"},
+				{ID: "f2", SourceRef: "gw", TargetRef: "task1"},
+				{ID: "f3", SourceRef: "gw", TargetRef: "end"},
+				{ID: "f4", SourceRef: "task1", TargetRef: "end"},
+			},
+			want: false,
+		},
+		{
+			name:    "diverging with two outgoing",
+			gateway: Gateway{ID: "gw", Type: "exclusiveGateway", GatewayDirection: "diverging"},
+			flows: []SequenceFlow{
+				{ID: "f1", SourceRef: "start", TargetRef: "gw"},
+				{ID: "f2", SourceRef: "gw", TargetRef: "task1"},
+				{ID: "f3", SourceRef: "gw", TargetRef: "end"},
+				{ID: "f4", SourceRef: "task1", TargetRef: "end"},
+			},
+			want: false,
+		},
+		{
+			name:    "inclusive gateway is not linted",
+			gateway: Gateway{ID: "gw", Type: "inclusiveGateway", GatewayDirection: "diverging"},
+			flows: []SequenceFlow{
+				{ID: "f1", SourceRef: "start", TargetRef: "gw"},
+				{ID: "f2", SourceRef: "gw", TargetRef: "task1"},
+				{ID: "f3", SourceRef: "task1", TargetRef: "end"},
+			},
+			want: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			process := &Process{
+				ProcessInfo: ProcessInfo{
+					ID: "test_process",
+					Elements: Elements{
+						Events: []Event{
+							{ID: "start", Type: "startEvent"},
+							{ID: "end", Type: "endEvent"},
+						},
+						Activities:    []Activity{{ID: "task1", Type: "userTask"}},
+						Gateways:      []Gateway{tt.gateway},
+						SequenceFlows: tt.flows,
+					},
+				},
+			}
+
+			warnings := NewAnalyzer(process).LintProcess()
+			if !tt.want {
+				if len(warnings) != 0 {
+					t.Errorf("Expected no warnings, got %v", warnings)
+				}
+				return
+			}
+			if len(warnings) != 1 {
+				t.Fatalf("Expected 1 warning, got %v", warnings)
+			}
+			w := warnings[0]
+			if w.Type != "single-branch-gateway" || w.ElementID != "gw" || w.ElementName != tt.gateway.Name {
+				t.Errorf("Unexpected warning: %+v", w)
+			}
+		})
+	}
+}
+
+func TestLintWarningsInReport(t *testing.T) {
+	process := &Process{
+		ProcessInfo: ProcessInfo{
+			ID: "test_process",
+			Elements: Elements{
+				Events: []Event{
+					{ID: "start", Type: "startEvent"},
+					{ID: "end", Type: "endEvent"},
+				},
+				Gateways: []Gateway{
+					{ID: "gw", Name: "Decide", Type: "exclusiveGateway", GatewayDirection: "diverging"},
+				},
+				SequenceFlows: []SequenceFlow{
+					{ID: "f1", SourceRef: "start", TargetRef: "gw"},
+					{ID: "f2", SourceRef: "gw", TargetRef: "end"},
+				},
+			},
+		},
+	}
+
+	report := FormatAnalysisReport(NewAnalyzer(process).Analyze())
+	if !strings.Contains(report, "Lint Warnings:") {
+		t.Fatalf("Report missing Lint Warnings section:\n%s", report)
+	}
+	if !strings.Contains(report, "gw (Decide): Diverging exclusiveGateway 'gw' has only one outgoing flow") {
+		t.Errorf("Report missing gateway warning:\n%s", report)
+	}
+}
+
+func TestLintUnassignedHumanTasks(t *testing.T) {
+	process := &Process{
+		ProcessInfo: ProcessInfo{
+			ID: "agents",
+			Elements: Elements{
+				Events: []Event{{ID: "start", Type: "startEvent"}, {ID: "end", Type: "endEvent"}},
+				Activities: []Activity{
+					{ID: "review", Name: "Review request", Type: "userTask"},
+					{ID: "sign", Name: "Sign paperwork", Type: "manualTask"},
+					{ID: "approve", Name: "Approve", Type: "userTask", Agent: &AgentAssignment{ID: "manager"}},
+					{ID: "triage", Name: "Triage", Type: "userTask", Agent: &AgentAssignment{Type: "human", Role: "support"}},
+					{ID: "check", Name: "Check", Type: "manualTask", Agent: &AgentAssignment{Type: "unspecified"}},
+					{ID: "notify", Name: "Notify", Type: "serviceTask"},
+				},
+				SequenceFlows: []SequenceFlow{
+					{ID: "f1", SourceRef: "start", TargetRef: "review"},
+					{ID: "f2", SourceRef: "review", TargetRef: "sign"},
+					{ID: "f3", SourceRef: "sign", TargetRef: "approve"},
+					{ID: "f4", SourceRef: "approve", TargetRef: "notify"},
+					{ID: "f5", SourceRef: "notify", TargetRef: "triage"},
+					{ID: "f6", SourceRef: "triage", TargetRef: "check"},
+					{ID: "f7", SourceRef: "check", TargetRef: "end"},
+				},
+			},
+		},
+	}
+
+	analyzer := NewAnalyzer(process)
+	for _, w := range analyzer.Analyze().LintWarnings {
+		if w.Type == "unassigned-human-task" {
+			t.Fatalf("Rule should be off by default, got %+v", w)
+		}
+	}
+
+	analyzer.SetRequireHumanAgents(true)
+	result := analyzer.Analyze()
+
+	var flagged []string
+	for _, w := range result.LintWarnings {
+		if w.Type != "unassigned-human-task" {
+			continue
+		}
+		if !w.IsError() {
+			t.Errorf("%s should be an error", w.ElementID)
+		}
+		flagged = append(flagged, w.ElementID+"="+w.ElementName)
+	}
+	if got := strings.Join(flagged, ","); got != "review=Review request,sign=Sign paperwork,check=Check" {
+		t.Errorf("Flagged = %s, want the unassigned user and manual tasks only", got)
+	}
+
+	issues := result.StrictIssues()
+	if len(issues) != 1 || !strings.Contains(issues[0], "3 lint error(s)") {
+		t.Errorf("StrictIssues() = %v", issues)
+	}
+	if report := FormatAnalysisReport(result); !strings.Contains(report, "review (Review request)") {
+		t.Errorf("Report should name the flagged elements:\n%s", report)
+	}
+}
@@ -0,0 +1,116 @@
+package bpmn
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// ProcessToMermaid renders the control flow of a process as a Mermaid
+// flowchart for embedding in Markdown. Start events are circles, end events
+// double circles, other events stadiums, activities rounded boxes (call
+// activities and subprocesses subroutine boxes) and gateways rhombi labelled
+// with their type symbol. Nodes are emitted by element ID and edges by flow
+// ID, so the output is stable for a given process.
+func ProcessToMermaid(p *Process) string {
+	elements := p.ProcessInfo.Elements
+	ids := newMermaidIDs()
+
+	var sb strings.Builder
+	sb.WriteString("flowchart LR\n")
+
+	events := append([]Event{}, elements.Events...)
+	sort.Slice(events, func(i, j int) bool { return events[i].ID < events[j].ID })
+	for _, e := range events {
+		openShape, closeShape := "([", "])"
+		switch e.Type {
+		case "startEvent":
+			openShape, closeShape = "((", "))"
+		case "endEvent":
+			openShape, closeShape = "(((", ")))"
+		}
+		sb.WriteString(fmt.Sprintf("  %s%s%s%s\n", ids.get(e.ID), openShape, mermaidLabel(e.Name, e.ID), closeShape))
+	}
+
+	activities := append([]Activity{}, elements.Activities...)
+	sort.Slice(activities, func(i, j int) bool { return activities[i].ID < activities[j].ID })
+	for _, a := range activities {
+		openShape, closeShape := "(", ")"
+		if a.Type == "callActivity" || a.Type == "subProcess" {
+			openShape, closeShape = "[[", "]]"
+		}
+		sb.WriteString(fmt.Sprintf("  %s%s%s%s\n", ids.get(a.ID), openShape, mermaidLabel(a.Name, a.ID), closeShape))
+	}
+
+	gateways := append([]Gateway{}, elements.Gateways...)
+	sort.Slice(gateways, func(i, j int) bool { return gateways[i].ID < gateways[j].ID })
+	for _, g := range gateways {
+		symbol := gatewaySymbol(g.Type)
+		if g.Type == "eventBasedGateway" {
+			symbol = "E"
+		}
+		label := symbol
+		if g.Name != "" {
+			label = symbol + " " + g.Name
+		}
+		sb.WriteString(fmt.Sprintf("  %s{%s}\n", ids.get(g.ID), mermaidLabel(label, g.ID)))
+	}
+
+	flows := append([]SequenceFlow{}, elements.SequenceFlows...)
+	sort.Slice(flows, func(i, j int) bool { return flows[i].ID < flows[j].ID })
+	for _, f := range flows {
+		arrow := "-->"
+		if f.Name != "" {
+			arrow = fmt.Sprintf("-->|%s|", mermaidLabel(f.Name, ""))
+		}
+		sb.WriteString(fmt.Sprintf("  %s %s %s\n", ids.get(f.SourceRef), arrow, ids.get(f.TargetRef)))
+	}
+
+	return sb.String()
+}
+
+// mermaidLabel quotes a node or edge label, falling back to the element ID
+func mermaidLabel(name, id string) string {
+	if name == "" {
+		name = id
+	}
+	return `"` + strings.ReplaceAll(name, `"`, "#quot;") + `"`
+}
+
+// mermaidIDs maps element IDs to Mermaid node IDs, which are limited to
+// letters, digits and underscores and may not be the keyword "end"
+type mermaidIDs struct {
+	ids  map[string]string
+	used map[string]bool
+}
+
+func newMermaidIDs() *mermaidIDs {
+	return &mermaidIDs{ids: make(map[string]string), used: make(map[string]bool)}
+}
+
+func (m *mermaidIDs) get(id string) string {
+	if mapped, ok := m.ids[id]; ok {
+		return mapped
+	}
+
+	var sb strings.Builder
+	for _, r := range id {
+		if (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') || (r >= '0' && r <= '9') || r == '_' {
+			sb.WriteRune(r)
+		} else {
+			sb.WriteRune('_')
+		}
+	}
+	base := sb.String()
+	if base == "" || strings.EqualFold(base, "end") {
+		base += "_"
+	}
+
+	mapped := base
+	for n := 2; m.used[mapped]; n++ {
+		mapped = fmt.Sprintf("%s_%d", base, n)
+	}
+	m.used[mapped] = true
+	m.ids[id] = mapped
+	return mapped
+}
@@ -0,0 +1,76 @@
+package bpmn
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestProcessToMermaid(t *testing.T) {
+	process := &Process{
+		ProcessInfo: ProcessInfo{
+			ID:   "order",
+			Name: "Order",
+			Elements: Elements{
+				Events: []Event{
+					{ID: "start", Type: "startEvent", Name: "Order placed"},
+					{ID: "wait", Type: "intermediateCatchEvent", Name: "Payment \"received\""},
+					{ID: "end", Type: "endEvent", Name: "Done"},
+				},
+				Activities: []Activity{
+					{ID: "review-order", Type: "userTask", Name: "Review order"},
+					{ID: "ship", Type: "callActivity", Name: "Ship"},
+				},
+				Gateways: []Gateway{
+					{ID: "approved", Type: "exclusiveGateway", Name: "Approved?"},
+					{ID: "fork", Type: "parallelGateway"},
+				},
+				SequenceFlows: []SequenceFlow{
+					{ID: "f3", SourceRef: "approved", TargetRef: "ship", Name: "yes"},
+					{ID: "f1", SourceRef: "start", TargetRef: "review-order"},
+					{ID: "f2", SourceRef: "review-order", TargetRef: "approved"},
+					{ID: "f4", SourceRef: "approved", TargetRef: "end", Name: "no"},
+					{ID: "f5", SourceRef: "ship", TargetRef: "fork"},
+					{ID: "f6", SourceRef: "fork", TargetRef: "wait"},
+					{ID: "f7", SourceRef: "wait", TargetRef: "end"},
+				},
+			},
+		},
+	}
+
+	want := `flowchart LR
+  end_((("Done")))
+  start(("Order placed"))
+  wait(["Payment #quot;received#quot;"])
+  review_order("Review order")
+  ship[["Ship"]]
+  approved{"X Approved?"}
+  fork{"+"}
+  start --> review_order
+  review_order --> approved
+  approved -->|"yes"| ship
+  approved -->|"no"| end_
+  ship --> fork
+  fork --> wait
+  wait --> end_
+`
+	got := ProcessToMermaid(process)
+	if got != want {
+		t.Errorf("ProcessToMermaid() =\n%s\nwant:\n%s", got, want)
+	}
+
+	// Output does not depend on element order
+	elements := &process.ProcessInfo.Elements
+	elements.Events[0], elements.Events[2] = elements.Events[2], elements.Events[0]
+	elements.SequenceFlows[0], elements.SequenceFlows[6] = elements.SequenceFlows[6], elements.SequenceFlows[0]
+	if again := ProcessToMermaid(process); again != got {
+		t.Errorf("Output changed with element order:\n%s", again)
+	}
+}
+
+func TestMermaidIDsAvoidCollisions(t *testing.T) {
+	ids := newMermaidIDs()
+	got := []string{ids.get("a-b"), ids.get("a_b"), ids.get("a-b"), ids.get("END")}
+	if strings.Join(got, ",") != "a_b,a_b_2,a_b,END_" {
+		t.Errorf("Unexpected IDs: %v", got)
+	}
+}
@@ -0,0 +1,220 @@
+package bpmn
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Message flow issue types
+const (
+	MessageSendWithoutReceive = "send-without-receive"
+	MessageReceiveWithoutSend = "receive-without-send"
+	MessageSamePool           = "same-pool"
+)
+
+// MessageFlowIssue describes a message flow between pools that does not line
+// up with a sender and a receiver. Element IDs are qualified by the process
+// (pool) they belong to.
+type MessageFlowIssue struct {
+	Type          string `json:"type"`
+	MessageFlowID string `json:"message_flow_id,omitempty"`
+	SourceRef     string `json:"source_ref,omitempty"`
+	SourceProcess string `json:"source_process,omitempty"`
+	TargetRef     string `json:"target_ref,omitempty"`
+	TargetProcess string `json:"target_process,omitempty"`
+	Message       string `json:"message"`
+}
+
+// messageEndpoint is an element or pool a message flow can connect to
+type messageEndpoint struct {
+	process    string
+	canSend    bool
+	canReceive bool
+	// needsFlow is set for elements that only make sense with a message
+	// flow attached, such as send tasks and message catch events
+	needsFlow bool
+}
+
+// analyzeMessageFlows checks the message flows declared by the processes'
+// collaborations against the elements of every process. Processes are
+// visited in the order of ids; a flow declared by more than one file is
+// checked once, and an element ID used in several processes resolves to the
+// first of them.
+func analyzeMessageFlows(processes map[string]*Process, ids []string) []MessageFlowIssue {
+	if !hasCollaboration(processes, ids) {
+		return nil
+	}
+
+	endpoints := make(map[string]messageEndpoint)
+	var flows []MessageFlow
+	seenFlows := make(map[string]bool)
+
+	for _, id := range ids {
+		elements := processes[id].ProcessInfo.Elements
+		for _, e := range elements.Events {
+			if _, exists := endpoints[e.ID]; exists {
+				continue
+			}
+			endpoints[e.ID] = eventEndpoint(id, e)
+		}
+		for _, a := range elements.Activities {
+			if _, exists := endpoints[a.ID]; exists {
+				continue
+			}
+			endpoints[a.ID] = messageEndpoint{
+				process:    id,
+				canSend:    a.Type != "receiveTask",
+				canReceive: a.Type != "sendTask",
+				needsFlow:  a.Type == "sendTask" || a.Type == "receiveTask",
+			}
+		}
+		for _, g := range elements.Gateways {
+			if _, exists := endpoints[g.ID]; exists {
+				continue
+			}
+			endpoints[g.ID] = messageEndpoint{process: id}
+		}
+	}
+
+	for _, id := range ids {
+		collab := processes[id].Collaboration
+		if collab == nil {
+			continue
+		}
+		// A flow may also connect to a pool as a whole
+		for _, participant := range collab.Participants {
+			if _, exists := endpoints[participant.ID]; exists {
+				continue
+			}
+			endpoints[participant.ID] = messageEndpoint{process: participant.ProcessRef, canSend: true, canReceive: true}
+		}
+		for _, flow := range collab.MessageFlows {
+			if flow.ID != "" {
+				if seenFlows[flow.ID] {
+					continue
+				}
+				seenFlows[flow.ID] = true
+			}
+			flows = append(flows, flow)
+		}
+	}
+
+	var issues []MessageFlowIssue
+	sent := make(map[string]bool)
+	received := make(map[string]bool)
+
+	for _, flow := range flows {
+		source, sourceOK := endpoints[flow.SourceRef]
+		target, targetOK := endpoints[flow.TargetRef]
+		issue := MessageFlowIssue{
+			MessageFlowID: flow.ID,
+			SourceRef:     flow.SourceRef,
+			SourceProcess: source.process,
+			TargetRef:     flow.TargetRef,
+			TargetProcess: target.process,
+		}
+		sent[flow.SourceRef] = true
+		received[flow.TargetRef] = true
+
+		switch {
+		case !sourceOK:
+			issue.Type = MessageReceiveWithoutSend
+			issue.Message = fmt.Sprintf("Message flow '%s' comes from unknown element '%s'", flow.ID, flow.SourceRef)
+		case !source.canSend:
+			issue.Type = MessageReceiveWithoutSend
+			issue.Message = fmt.Sprintf("Message flow '%s' comes from '%s', which cannot send messages", flow.ID, flow.SourceRef)
+		case !targetOK:
+			issue.Type = MessageSendWithoutReceive
+			issue.Message = fmt.Sprintf("Message flow '%s' goes to unknown element '%s'", flow.ID, flow.TargetRef)
+		case !target.canReceive:
+			issue.Type = MessageSendWithoutReceive
+			issue.Message = fmt.Sprintf("Message flow '%s' goes to '%s', which cannot receive messages", flow.ID, flow.TargetRef)
+		case source.process != "" && source.process == target.process:
+			issue.Type = MessageSamePool
+			issue.Message = fmt.Sprintf("Message flow '%s' connects '%s' and '%s' within process '%s'", flow.ID, flow.SourceRef, flow.TargetRef, source.process)
+		default:
+			continue
+		}
+		issues = append(issues, issue)
+	}
+
+	// Senders and receivers left without a message flow
+	for _, id := range ids {
+		elements := processes[id].ProcessInfo.Elements
+		var elementIDs []string
+		for _, e := range elements.Events {
+			elementIDs = append(elementIDs, e.ID)
+		}
+		for _, a := range elements.Activities {
+			elementIDs = append(elementIDs, a.ID)
+		}
+
+		for _, elementID := range elementIDs {
+			endpoint := endpoints[elementID]
+			if endpoint.process != id || !endpoint.needsFlow {
+				continue
+			}
+			switch {
+			case endpoint.canSend && !endpoint.canReceive && !sent[elementID]:
+				issues = append(issues, MessageFlowIssue{
+					Type:          MessageSendWithoutReceive,
+					SourceRef:     elementID,
+					SourceProcess: id,
+					Message:       fmt.Sprintf("'%s' in process '%s' sends a message that no message flow delivers", elementID, id),
+				})
+			case endpoint.canReceive && !endpoint.canSend && !received[elementID]:
+				issues = append(issues, MessageFlowIssue{
+					Type:          MessageReceiveWithoutSend,
+					TargetRef:     elementID,
+					TargetProcess: id,
+					Message:       fmt.Sprintf("'%s' in process '%s' waits for a message that no message flow sends", elementID, id),
+				})
+			}
+		}
+	}
+
+	return issues
+}
+
+// checkMessageFlows checks the message flows of the process's own
+// collaboration. Elements of other pools are not known to a single process,
+// so flows to or from them are reported as unknown endpoints.
+func (a *Analyzer) checkMessageFlows() []MessageFlowIssue {
+	id := a.process.ProcessInfo.ID
+	return analyzeMessageFlows(map[string]*Process{id: a.process}, []string{id})
+}
+
+// messageFlowSummary describes message flow issues for strict analysis
+func messageFlowSummary(issues []MessageFlowIssue) string {
+	messages := make([]string, len(issues))
+	for i, issue := range issues {
+		messages[i] = issue.Message
+	}
+	return fmt.Sprintf("%d message flow issue(s): %s", len(issues), strings.Join(messages, ", "))
+}
+
+// eventEndpoint describes how an event can take part in message flows.
+// Only message events need a flow; other events may still be the source or
+// target of one.
+func eventEndpoint(process string, e Event) messageEndpoint {
+	endpoint := messageEndpoint{process: process}
+	switch e.Type {
+	case "startEvent", "intermediateCatchEvent", "boundaryEvent":
+		endpoint.canReceive = true
+	case "endEvent", "intermediateThrowEvent":
+		endpoint.canSend = true
+	}
+	endpoint.needsFlow = e.EventType == "message"
+	return endpoint
+}
+
+// hasCollaboration reports whether any of the processes declares a
+// collaboration. Without one, message elements are not checked.
+func hasCollaboration(processes map[string]*Process, ids []string) bool {
+	for _, id := range ids {
+		if processes[id].Collaboration != nil {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,325 @@
+package bpmn
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// CallLink describes a call activity in one process invoking another process
+type CallLink struct {
+	SourceProcess string `json:"source_process"`
+	ActivityID    string `json:"activity_id"`
+	CalledProcess string `json:"called_process"`
+}
+
+// MultiProcessResult contains the combined analysis of a set of processes
+type MultiProcessResult struct {
+	Processes            map[string]*AnalysisResult `json:"processes"`
+	CallLinks            []CallLink                 `json:"call_links"`
+	UnresolvedCalls      []CallLink                 `json:"unresolved_calls,omitempty"`
+	EntryProcesses       []string                   `json:"entry_processes"`
+	UnreachableProcesses []string                   `json:"unreachable_processes,omitempty"`
+	Cycles               [][]string                 `json:"cycles,omitempty"`
+	MessageFlowIssues    []MessageFlowIssue         `json:"message_flow_issues,omitempty"`
+	Warnings             []string                   `json:"warnings,omitempty"`
+}
+
+// MultiProcessAnalyzer analyzes processes that invoke each other through
+// call activities
+type MultiProcessAnalyzer struct {
+	processes map[string]*Process
+	analyzers map[string]*Analyzer
+	ids       []string
+}
+
+// NewMultiProcessAnalyzer creates an analyzer for a set of processes.
+// Processes are keyed by their process ID, which call activities reference
+// through calledElement.
+func NewMultiProcessAnalyzer(processes ...*Process) (*MultiProcessAnalyzer, error) {
+	m := &MultiProcessAnalyzer{
+		processes: make(map[string]*Process),
+		analyzers: make(map[string]*Analyzer),
+	}
+
+	for _, p := range processes {
+		id := p.ProcessInfo.ID
+		if id == "" {
+			return nil, fmt.Errorf("process has no ID")
+		}
+		if _, exists := m.processes[id]; exists {
+			return nil, fmt.Errorf("duplicate process ID: %s", id)
+		}
+		m.processes[id] = p
+		m.analyzers[id] = NewAnalyzer(p)
+		m.ids = append(m.ids, id)
+	}
+	sort.Strings(m.ids)
+
+	return m, nil
+}
+
+// SetRequireHumanAgents controls whether unassigned user and manual tasks
+// are reported as lint errors in every process
+func (m *MultiProcessAnalyzer) SetRequireHumanAgents(require bool) {
+	for _, analyzer := range m.analyzers {
+		analyzer.SetRequireHumanAgents(require)
+	}
+}
+
+// AnalyzeWithThresholds analyzes the processes and checks each one's metrics
+// against the given thresholds
+func (m *MultiProcessAnalyzer) AnalyzeWithThresholds(t MetricThresholds) *MultiProcessResult {
+	result := m.Analyze()
+	for _, id := range m.ids {
+		process := result.Processes[id]
+		process.Thresholds = &t
+		process.Violations = checkMetricThresholds(process.Metrics, t)
+	}
+	return result
+}
+
+// Analyze analyzes each process and the call links between them
+func (m *MultiProcessAnalyzer) Analyze() *MultiProcessResult {
+	result := &MultiProcessResult{
+		Processes: make(map[string]*AnalysisResult),
+		CallLinks: []CallLink{},
+	}
+
+	for _, id := range m.ids {
+		process := m.analyzers[id].Analyze()
+		// Message flows are checked across all pools below, where elements
+		// of the other processes are known
+		process.MessageFlowIssues = nil
+		result.Processes[id] = process
+	}
+
+	// Resolve call activities to the processes they invoke
+	called := make(map[string]bool)
+	for _, id := range m.ids {
+		for _, act := range m.processes[id].ProcessInfo.Elements.Activities {
+			if act.Type != "callActivity" {
+				continue
+			}
+			link := CallLink{SourceProcess: id, ActivityID: act.ID, CalledProcess: act.CalledElement}
+			if _, ok := m.processes[act.CalledElement]; !ok {
+				result.UnresolvedCalls = append(result.UnresolvedCalls, link)
+				if act.CalledElement == "" {
+					result.Warnings = append(result.Warnings, fmt.Sprintf("Call activity '%s' in process '%s' has no calledElement", act.ID, id))
+				} else {
+					result.Warnings = append(result.Warnings, fmt.Sprintf("Call activity '%s' in process '%s' calls unknown process '%s'", act.ID, id, act.CalledElement))
+				}
+				continue
+			}
+			result.CallLinks = append(result.CallLinks, link)
+			if act.CalledElement != id {
+				called[act.CalledElement] = true
+			}
+		}
+	}
+
+	result.EntryProcesses = []string{}
+	for _, id := range m.ids {
+		if !called[id] {
+			result.EntryProcesses = append(result.EntryProcesses, id)
+		}
+	}
+
+	result.UnreachableProcesses = m.findUnreachableProcesses(result)
+	result.Cycles = findCallCycles(m.ids, result.CallLinks)
+	result.MessageFlowIssues = analyzeMessageFlows(m.processes, m.ids)
+
+	return result
+}
+
+// ThresholdViolations lists the metrics that exceed their thresholds in any
+// process, each prefixed by the process ID
+func (r *MultiProcessResult) ThresholdViolations() []string {
+	var violations []string
+	for _, id := range r.processIDs() {
+		for _, v := range r.Processes[id].Violations {
+			violations = append(violations, fmt.Sprintf("%s: %s %g (max %g)", id, v.Metric, v.Value, v.Threshold))
+		}
+	}
+	return violations
+}
+
+// StrictIssues summarizes the findings that fail strict analysis: those of
+// each process, prefixed by the process ID, and message flow issues across
+// the pools
+func (r *MultiProcessResult) StrictIssues() []string {
+	issues := r.processIssues()
+	if len(r.MessageFlowIssues) > 0 {
+		issues = append(issues, messageFlowSummary(r.MessageFlowIssues))
+	}
+	return issues
+}
+
+// processIssues returns the strict issues of each process, prefixed by the
+// process ID
+func (r *MultiProcessResult) processIssues() []string {
+	var issues []string
+	for _, id := range r.processIDs() {
+		for _, issue := range r.Processes[id].StrictIssues() {
+			issues = append(issues, fmt.Sprintf("%s: %s", id, issue))
+		}
+	}
+	return issues
+}
+
+func (r *MultiProcessResult) processIDs() []string {
+	ids := make([]string, 0, len(r.Processes))
+	for id := range r.Processes {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	return ids
+}
+
+// findUnreachableProcesses returns the processes that cannot be reached from
+// an entry process. A call link only counts when its call activity is itself
+// reachable from the start of the calling process.
+func (m *MultiProcessAnalyzer) findUnreachableProcesses(result *MultiProcessResult) []string {
+	reached := make(map[string]bool)
+	queue := append([]string{}, result.EntryProcesses...)
+	for _, id := range queue {
+		reached[id] = true
+	}
+
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+
+		reachable := result.Processes[current].Reachability.ReachableFromStart
+		for _, link := range result.CallLinks {
+			if link.SourceProcess != current || !reachable[link.ActivityID] || reached[link.CalledProcess] {
+				continue
+			}
+			reached[link.CalledProcess] = true
+			queue = append(queue, link.CalledProcess)
+		}
+	}
+
+	var unreachable []string
+	for _, id := range m.ids {
+		if !reached[id] {
+			unreachable = append(unreachable, id)
+		}
+	}
+	return unreachable
+}
+
+// findCallCycles returns each distinct cycle of processes calling each other
+func findCallCycles(ids []string, links []CallLink) [][]string {
+	graph := make(map[string][]string)
+	for _, link := range links {
+		if !contains(graph[link.SourceProcess], link.CalledProcess) {
+			graph[link.SourceProcess] = append(graph[link.SourceProcess], link.CalledProcess)
+		}
+	}
+	for id := range graph {
+		sort.Strings(graph[id])
+	}
+
+	visited := make(map[string]bool)
+	onStack := make(map[string]bool)
+	var cycles [][]string
+
+	var visit func(id string, path []string)
+	visit = func(id string, path []string) {
+		visited[id] = true
+		onStack[id] = true
+		path = append(path, id)
+
+		for _, next := range graph[id] {
+			if onStack[next] {
+				for i, n := range path {
+					if n == next {
+						cycles = append(cycles, append([]string{}, path[i:]...))
+						break
+					}
+				}
+			} else if !visited[next] {
+				visit(next, path)
+			}
+		}
+
+		onStack[id] = false
+	}
+
+	for _, id := range ids {
+		if !visited[id] {
+			visit(id, nil)
+		}
+	}
+
+	return cycles
+}
+
+// FormatMultiProcessReport creates a human-readable report of a multi-process analysis
+func FormatMultiProcessReport(result *MultiProcessResult) string {
+	var report strings.Builder
+
+	report.WriteString("=== BPMN Multi-Process Analysis Report ===\n\n")
+
+	report.WriteString(fmt.Sprintf("Processes: %d\n", len(result.Processes)))
+	report.WriteString(fmt.Sprintf("Entry Processes: %s\n\n", strings.Join(result.EntryProcesses, ", ")))
+
+	report.WriteString("Call Links:\n")
+	if len(result.CallLinks) > 0 {
+		for _, link := range result.CallLinks {
+			report.WriteString(fmt.Sprintf("  - %s.%s -> %s\n", link.SourceProcess, link.ActivityID, link.CalledProcess))
+		}
+	} else {
+		report.WriteString("  (none)\n")
+	}
+	report.WriteString("\n")
+
+	report.WriteString("Cross-Process Reachability:\n")
+	if len(result.UnreachableProcesses) > 0 {
+		report.WriteString("  ⚠️  Unreachable Processes:\n")
+		for _, id := range result.UnreachableProcesses {
+			report.WriteString(fmt.Sprintf("    - %s\n", id))
+		}
+	} else {
+		report.WriteString("  ✓ All processes are reachable from an entry process\n")
+	}
+	report.WriteString("\n")
+
+	report.WriteString("Call Cycles:\n")
+	if len(result.Cycles) > 0 {
+		for _, cycle := range result.Cycles {
+			report.WriteString(fmt.Sprintf("  ✗ %s\n", strings.Join(append(append([]string{}, cycle...), cycle[0]), " -> ")))
+		}
+	} else {
+		report.WriteString("  ✓ No call cycles detected\n")
+	}
+
+	violations := result.ThresholdViolations()
+	issues := result.processIssues()
+	if len(violations) > 0 || len(issues) > 0 {
+		report.WriteString("\nProcess Issues:\n")
+		for _, v := range violations {
+			report.WriteString(fmt.Sprintf("  ✗ %s\n", v))
+		}
+		for _, issue := range issues {
+			report.WriteString(fmt.Sprintf("  ✗ %s\n", issue))
+		}
+	}
+
+	if len(result.MessageFlowIssues) > 0 {
+		report.WriteString("\nMessage Flow Issues:\n")
+		for _, issue := range result.MessageFlowIssues {
+			report.WriteString(fmt.Sprintf("  ✗ [%s] %s\n", issue.Type, issue.Message))
+		}
+	}
+
+	if len(result.Warnings) > 0 {
+		report.WriteString("\nWarnings:\n")
+		for _, w := range result.Warnings {
+			report.WriteString(fmt.Sprintf("  ⚠️  %s\n", w))
+		}
+	}
+
+	return report.String()
+}
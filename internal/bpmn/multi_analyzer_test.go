@@ -0,0 +1,306 @@
+package bpmn
+
+import (
+	"strings"
+	"testing"
+)
+
+// newCallingProcess builds start -> activity -> end where the activity calls
+// the given process (or is a plain task when calls is empty)
+func newCallingProcess(id, calls string) *Process {
+	act := Activity{ID: id + "_task", Name: "Task", Type: "userTask"}
+	if calls != "" {
+		act = Activity{ID: id + "_call", Name: "Call " + calls, Type: "callActivity", CalledElement: calls}
+	}
+	return &Process{
+		ProcessInfo: ProcessInfo{
+			ID: id,
+			Elements: Elements{
+				Events: []Event{
+					{ID: "start", Type: "startEvent"},
+					{ID: "end", Type: "endEvent"},
+				},
+				Activities: []Activity{act},
+				SequenceFlows: []SequenceFlow{
+					{ID: "flow1", SourceRef: "start", TargetRef: act.ID},
+					{ID: "flow2", SourceRef: act.ID, TargetRef: "end"},
+				},
+			},
+		},
+	}
+}
+
+func TestMultiProcessAnalyzerLinkedProcesses(t *testing.T) {
+	analyzer, err := NewMultiProcessAnalyzer(
+		newCallingProcess("order", "payment"),
+		newCallingProcess("payment", ""),
+		newCallingProcess("orphan", ""),
+	)
+	if err != nil {
+		t.Fatalf("NewMultiProcessAnalyzer() error = %v", err)
+	}
+	result := analyzer.Analyze()
+
+	if len(result.Processes) != 3 {
+		t.Errorf("Expected 3 process results, got %d", len(result.Processes))
+	}
+	if len(result.CallLinks) != 1 || result.CallLinks[0] != (CallLink{"order", "order_call", "payment"}) {
+		t.Errorf("Unexpected call links: %v", result.CallLinks)
+	}
+	if strings.Join(result.EntryProcesses, ",") != "order,orphan" {
+		t.Errorf("EntryProcesses = %v, want [order orphan]", result.EntryProcesses)
+	}
+	if len(result.UnreachableProcesses) != 0 {
+		t.Errorf("Expected all processes reachable, got %v", result.UnreachableProcesses)
+	}
+	if len(result.Cycles) != 0 || len(result.Warnings) != 0 {
+		t.Errorf("Expected no cycles or warnings, got %v %v", result.Cycles, result.Warnings)
+	}
+}
+
+func TestMultiProcessAnalyzerUnreachableCall(t *testing.T) {
+	// The call activity in "order" is not connected to the start event, so
+	// "payment" is never invoked
+	order := newCallingProcess("order", "payment")
+	order.ProcessInfo.Elements.SequenceFlows = []SequenceFlow{
+		{ID: "flow1", SourceRef: "start", TargetRef: "end"},
+		{ID: "flow2", SourceRef: "order_call", TargetRef: "end"},
+	}
+
+	analyzer, err := NewMultiProcessAnalyzer(order, newCallingProcess("payment", ""))
+	if err != nil {
+		t.Fatalf("NewMultiProcessAnalyzer() error = %v", err)
+	}
+	result := analyzer.Analyze()
+
+	if len(result.UnreachableProcesses) != 1 || result.UnreachableProcesses[0] != "payment" {
+		t.Errorf("UnreachableProcesses = %v, want [payment]", result.UnreachableProcesses)
+	}
+}
+
+func TestMultiProcessAnalyzerCycle(t *testing.T) {
+	analyzer, err := NewMultiProcessAnalyzer(
+		newCallingProcess("main", "a"),
+		newCallingProcess("a", "b"),
+		newCallingProcess("b", "a"),
+	)
+	if err != nil {
+		t.Fatalf("NewMultiProcessAnalyzer() error = %v", err)
+	}
+	result := analyzer.Analyze()
+
+	if len(result.Cycles) != 1 || strings.Join(result.Cycles[0], ",") != "a,b" {
+		t.Fatalf("Cycles = %v, want [[a b]]", result.Cycles)
+	}
+
+	report := FormatMultiProcessReport(result)
+	if !strings.Contains(report, "✗ a -> b -> a") {
+		t.Errorf("Report missing cycle:\n%s", report)
+	}
+}
+
+func TestMultiProcessAnalyzerUnresolvedCall(t *testing.T) {
+	noTarget := newCallingProcess("billing", "")
+	noTarget.ProcessInfo.Elements.Activities[0].Type = "callActivity"
+
+	analyzer, err := NewMultiProcessAnalyzer(newCallingProcess("order", "missing"), noTarget)
+	if err != nil {
+		t.Fatalf("NewMultiProcessAnalyzer() error = %v", err)
+	}
+	result := analyzer.Analyze()
+
+	if len(result.UnresolvedCalls) != 2 || len(result.Warnings) != 2 {
+		t.Fatalf("Expected 2 unresolved calls and warnings, got %v %v", result.UnresolvedCalls, result.Warnings)
+	}
+	if !strings.Contains(result.Warnings[0], "has no calledElement") {
+		t.Errorf("Unexpected warning: %s", result.Warnings[0])
+	}
+	if !strings.Contains(result.Warnings[1], "unknown process 'missing'") {
+		t.Errorf("Unexpected warning: %s", result.Warnings[1])
+	}
+}
+
+func TestMultiProcessAnalyzerDuplicateID(t *testing.T) {
+	if _, err := NewMultiProcessAnalyzer(newCallingProcess("order", ""), newCallingProcess("order", "")); err == nil {
+		t.Error("Expected error for duplicate process IDs")
+	}
+}
+
+// newOrderCollaboration builds two pools where the customer sends an order
+// the supplier receives; the supplier sends an invoice over a flow that points
+// at the customer's send task, and the customer's payment receipt is never
+// sent. Only the customer declares the collaboration.
+func newOrderCollaboration() (customer, supplier *Process) {
+	customer = &Process{
+		ProcessInfo: ProcessInfo{
+			ID: "customer",
+			Elements: Elements{
+				Events: []Event{
+					{ID: "customer_start", Type: "startEvent"},
+					{ID: "payment_received", Type: "intermediateCatchEvent", EventType: "message"},
+					{ID: "customer_end", Type: "endEvent"},
+				},
+				Activities: []Activity{
+					{ID: "send_order", Name: "Send order", Type: "sendTask"},
+				},
+				SequenceFlows: []SequenceFlow{
+					{ID: "c1", SourceRef: "customer_start", TargetRef: "send_order"},
+					{ID: "c2", SourceRef: "send_order", TargetRef: "payment_received"},
+					{ID: "c3", SourceRef: "payment_received", TargetRef: "customer_end"},
+				},
+			},
+		},
+		Collaboration: &Collaboration{
+			ID: "order_collaboration",
+			Participants: []Participant{
+				{ID: "customer_pool", ProcessRef: "customer"},
+				{ID: "supplier_pool", ProcessRef: "supplier"},
+			},
+			MessageFlows: []MessageFlow{
+				{ID: "order_message", SourceRef: "send_order", TargetRef: "receive_order"},
+				{ID: "invoice_message", SourceRef: "send_invoice", TargetRef: "send_order"},
+			},
+		},
+	}
+	supplier = &Process{
+		ProcessInfo: ProcessInfo{
+			ID: "supplier",
+			Elements: Elements{
+				Events: []Event{
+					{ID: "supplier_start", Type: "startEvent"},
+					{ID: "supplier_end", Type: "endEvent"},
+				},
+				Activities: []Activity{
+					{ID: "receive_order", Name: "Receive order", Type: "receiveTask"},
+					{ID: "send_invoice", Name: "Send invoice", Type: "sendTask"},
+				},
+				SequenceFlows: []SequenceFlow{
+					{ID: "s1", SourceRef: "supplier_start", TargetRef: "receive_order"},
+					{ID: "s2", SourceRef: "receive_order", TargetRef: "send_invoice"},
+					{ID: "s3", SourceRef: "send_invoice", TargetRef: "supplier_end"},
+				},
+			},
+		},
+	}
+	return customer, supplier
+}
+
+func TestMultiProcessAnalyzerMessageFlows(t *testing.T) {
+	analyzer, err := NewMultiProcessAnalyzer(newOrderCollaboration())
+	if err != nil {
+		t.Fatalf("NewMultiProcessAnalyzer() error = %v", err)
+	}
+	result := analyzer.Analyze()
+	issues := result.MessageFlowIssues
+
+	if len(issues) != 2 {
+		t.Fatalf("Expected 2 message flow issues, got %d: %+v", len(issues), issues)
+	}
+
+	invoice := issues[0]
+	if invoice.Type != MessageSendWithoutReceive || invoice.MessageFlowID != "invoice_message" {
+		t.Errorf("Unexpected first issue: %+v", invoice)
+	}
+	if invoice.SourceRef != "send_invoice" || invoice.SourceProcess != "supplier" ||
+		invoice.TargetRef != "send_order" || invoice.TargetProcess != "customer" {
+		t.Errorf("Issue should reference elements across pools: %+v", invoice)
+	}
+
+	payment := issues[1]
+	if payment.Type != MessageReceiveWithoutSend || payment.TargetRef != "payment_received" || payment.TargetProcess != "customer" {
+		t.Errorf("Unexpected second issue: %+v", payment)
+	}
+
+	// The per-process results leave message flows to the combined check
+	for id, process := range result.Processes {
+		if len(process.MessageFlowIssues) != 0 {
+			t.Errorf("Process %s should not report message flows on its own: %+v", id, process.MessageFlowIssues)
+		}
+	}
+	strict := result.StrictIssues()
+	if len(strict) != 1 || !strings.HasPrefix(strict[0], "2 message flow issue(s)") {
+		t.Errorf("Message flow issues should fail strict analysis, got %v", strict)
+	}
+}
+
+func TestAnalyzerMessageFlowsSingleProcess(t *testing.T) {
+	customer, _ := newOrderCollaboration()
+	result := NewAnalyzer(customer).Analyze()
+
+	// The supplier's elements are unknown without its file
+	issues := result.MessageFlowIssues
+	if len(issues) != 3 {
+		t.Fatalf("Expected 3 message flow issues, got %d: %+v", len(issues), issues)
+	}
+	if issues[0].MessageFlowID != "order_message" || issues[0].Type != MessageSendWithoutReceive ||
+		!strings.Contains(issues[0].Message, "unknown element 'receive_order'") {
+		t.Errorf("Unexpected first issue: %+v", issues[0])
+	}
+	if issues[1].MessageFlowID != "invoice_message" || issues[1].Type != MessageReceiveWithoutSend ||
+		!strings.Contains(issues[1].Message, "unknown element 'send_invoice'") {
+		t.Errorf("Unexpected second issue: %+v", issues[1])
+	}
+	if issues[2].TargetRef != "payment_received" || issues[2].Type != MessageReceiveWithoutSend {
+		t.Errorf("Unexpected third issue: %+v", issues[2])
+	}
+
+	strict := result.StrictIssues()
+	if len(strict) != 1 || !strings.HasPrefix(strict[0], "3 message flow issue(s)") {
+		t.Errorf("Message flow issues should fail strict analysis, got %v", strict)
+	}
+	if report := FormatAnalysisReport(result); !strings.Contains(report, "Message Flow Issues:") {
+		t.Errorf("Report should list message flow issues:\n%s", report)
+	}
+
+	// Without a collaboration there is nothing to check
+	if issues := NewAnalyzer(newCallingProcess("order", "")).Analyze().MessageFlowIssues; issues != nil {
+		t.Errorf("Expected no message flow issues without a collaboration, got %+v", issues)
+	}
+}
+
+func TestMultiProcessAnalyzerNoCollaboration(t *testing.T) {
+	p := newCallingProcess("order", "")
+	p.ProcessInfo.Elements.Activities[0].Type = "sendTask"
+
+	analyzer, err := NewMultiProcessAnalyzer(p)
+	if err != nil {
+		t.Fatalf("NewMultiProcessAnalyzer() error = %v", err)
+	}
+	if issues := analyzer.Analyze().MessageFlowIssues; len(issues) != 0 {
+		t.Errorf("Expected no message flow checks without a collaboration, got %+v", issues)
+	}
+}
+
+func TestMultiProcessAnalyzerPerProcessChecks(t *testing.T) {
+	order := newCallingProcess("order", "payment")
+	order.ProcessInfo.Elements.Activities = append(order.ProcessInfo.Elements.Activities,
+		Activity{ID: "orphan", Name: "Orphan", Type: "serviceTask"})
+
+	analyzer, err := NewMultiProcessAnalyzer(order, newCallingProcess("payment", ""))
+	if err != nil {
+		t.Fatalf("NewMultiProcessAnalyzer() error = %v", err)
+	}
+	analyzer.SetRequireHumanAgents(true)
+	result := analyzer.AnalyzeWithThresholds(MetricThresholds{MaxComplexity: 1})
+
+	violations := result.ThresholdViolations()
+	if len(violations) != 2 || !strings.HasPrefix(violations[0], "order: complexity") || !strings.HasPrefix(violations[1], "payment: complexity") {
+		t.Errorf("Expected a complexity violation for each process, got %v", violations)
+	}
+
+	issues := result.StrictIssues()
+	if len(issues) != 2 {
+		t.Fatalf("Expected 2 strict issues, got %v", issues)
+	}
+	if !strings.HasPrefix(issues[0], "order: 1 unreachable element(s): orphan") {
+		t.Errorf("Expected the orphan task to be reported for order, got %q", issues[0])
+	}
+	if !strings.HasPrefix(issues[1], "payment: 1 lint error(s)") {
+		t.Errorf("Expected the unassigned user task to be reported for payment, got %q", issues[1])
+	}
+
+	report := FormatMultiProcessReport(result)
+	if !strings.Contains(report, "Process Issues:") || !strings.Contains(report, "order: 1 unreachable element(s)") {
+		t.Errorf("Report should list per-process issues:\n%s", report)
+	}
+}
@@ -253,41 +253,7 @@ func (r *Renderer) renderDOT() string {
 
 // renderMermaid renders a Mermaid diagram
 func (r *Renderer) renderMermaid() string {
-	var sb strings.Builder
-	sb.WriteString("graph LR\n")
-
-	// Events
-	for _, event := range r.process.ProcessInfo.Elements.Events {
-		shape := "()"
-		if event.Type == "endEvent" {
-			shape = "((()))"
-		}
-		sb.WriteString(fmt.Sprintf("  %s%s%s[%s]\n", 
-			event.ID, shape[:len(shape)/2], event.Name, shape[len(shape)/2:]))
-	}
-
-	// Activities
-	for _, activity := range r.process.ProcessInfo.Elements.Activities {
-		sb.WriteString(fmt.Sprintf("  %s[%s]\n", activity.ID, activity.Name))
-	}
-
-	// Gateways
-	for _, gateway := range r.process.ProcessInfo.Elements.Gateways {
-		symbol := gatewaySymbol(gateway.Type)
-		sb.WriteString(fmt.Sprintf("  %s{%s}\n", gateway.ID, symbol))
-	}
-
-	// Sequence Flows
-	for _, flow := range r.process.ProcessInfo.Elements.SequenceFlows {
-		arrow := "-->"
-		if flow.Name != "" {
-			arrow = fmt.Sprintf("-->|%s|", flow.Name)
-		}
-		sb.WriteString(fmt.Sprintf("  %s %s %s\n", 
-			flow.SourceRef, arrow, flow.TargetRef))
-	}
-
-	return sb.String()
+	return ProcessToMermaid(r.process)
 }
 
 // Helper rendering functions
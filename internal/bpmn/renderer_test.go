@@ -167,8 +167,8 @@ func TestRendererMermaidDiagram(t *testing.T) {
 		t.Error("Markdown output should contain mermaid diagram")
 	}
 
-	if !strings.Contains(output, "graph") {
-		t.Error("Should have graph declaration")
+	if !strings.Contains(output, "```mermaid\nflowchart LR\n") {
+		t.Error("Should have flowchart declaration")
 	}
 }
 
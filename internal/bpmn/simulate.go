@@ -0,0 +1,247 @@
+package bpmn
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// maxSimulationSteps bounds a simulation so that loops whose gateway
+// decisions never exit still terminate
+const maxSimulationSteps = 10000
+
+// SimulationResult is the outcome of playing tokens through a process
+type SimulationResult struct {
+	Activities []string     `json:"activities"`
+	EndEvents  []string     `json:"end_events"`
+	Stuck      []StuckToken `json:"stuck,omitempty"`
+	Steps      int          `json:"steps"`
+}
+
+// StuckToken describes a token that could not move on
+type StuckToken struct {
+	ElementID string `json:"element_id"`
+	Reason    string `json:"reason"`
+}
+
+// Completed reports whether every token reached an end event
+func (r *SimulationResult) Completed() bool {
+	return len(r.Stuck) == 0 && len(r.EndEvents) > 0
+}
+
+// Simulate plays tokens through the process, starting one token on each
+// start event. Decisions map a gateway or activity ID to the sequence flow
+// (by flow ID or target element ID) its token takes; a diverging exclusive,
+// inclusive or event-based gateway without a decision takes its default
+// flow, and the token is stuck if it has none. An activity whose outgoing
+// flows carry conditions is a choice as well: without a decision it takes
+// its default flow, or otherwise its unconditional flows. Parallel gateways
+// and other activities with several outgoing flows split a token into one
+// per flow, and a parallel gateway with several incoming flows waits for a
+// token on each of them.
+func (a *Analyzer) Simulate(decisions map[string]string) (*SimulationResult, error) {
+	targets, err := a.decisionTargets(decisions)
+	if err != nil {
+		return nil, err
+	}
+
+	eventTypes := make(map[string]string)
+	for _, e := range a.process.ProcessInfo.Elements.Events {
+		eventTypes[e.ID] = e.Type
+	}
+
+	result := &SimulationResult{
+		Activities: []string{},
+		EndEvents:  []string{},
+	}
+	queue := a.findStartEvents()
+	arrived := make(map[string]int)
+
+	for len(queue) > 0 {
+		if result.Steps >= maxSimulationSteps {
+			for _, id := range queue {
+				result.Stuck = append(result.Stuck, StuckToken{id, fmt.Sprintf("step limit of %d reached; a loop may never exit", maxSimulationSteps)})
+			}
+			break
+		}
+		result.Steps++
+
+		current := queue[0]
+		queue = queue[1:]
+		outgoing := a.graph[current]
+
+		if a.isActivity(current) {
+			result.Activities = append(result.Activities, current)
+		}
+		if eventTypes[current] == "endEvent" {
+			result.EndEvents = append(result.EndEvents, current)
+			continue
+		}
+
+		if gateway := a.findGateway(current); gateway != nil {
+			if gateway.Type == "parallelGateway" {
+				incoming := len(a.reverse[current])
+				arrived[current]++
+				if arrived[current] < incoming {
+					continue
+				}
+				arrived[current] = 0
+			} else if len(outgoing) > 1 {
+				next, ok := targets[current]
+				if !ok {
+					next, ok = a.defaultTarget(gateway)
+				}
+				if !ok {
+					result.Stuck = append(result.Stuck, StuckToken{current, "no decision for diverging gateway"})
+					continue
+				}
+				outgoing = []string{next}
+			}
+		} else if len(outgoing) > 1 && a.hasConditionalFlows(current) {
+			if next, ok := targets[current]; ok {
+				outgoing = []string{next}
+			} else {
+				outgoing = a.unconditionalTargets(current)
+				if len(outgoing) == 0 {
+					result.Stuck = append(result.Stuck, StuckToken{current, "no decision for conditional flows"})
+					continue
+				}
+			}
+		}
+
+		if len(outgoing) == 0 {
+			result.Stuck = append(result.Stuck, StuckToken{current, "no outgoing sequence flow"})
+			continue
+		}
+		queue = append(queue, outgoing...)
+	}
+
+	// Tokens left waiting at a parallel join are deadlocked
+	var waiting []string
+	for id, count := range arrived {
+		if count > 0 {
+			waiting = append(waiting, id)
+		}
+	}
+	sort.Strings(waiting)
+	for _, id := range waiting {
+		result.Stuck = append(result.Stuck, StuckToken{id, fmt.Sprintf("parallel join received %d of %d incoming tokens", arrived[id], len(a.reverse[id]))})
+	}
+
+	return result, nil
+}
+
+// decisionTargets resolves gateway decisions to the element each one leads to
+func (a *Analyzer) decisionTargets(decisions map[string]string) (map[string]string, error) {
+	targets := make(map[string]string, len(decisions))
+
+	for elementID, choice := range decisions {
+		if a.findGateway(elementID) == nil && !a.isActivity(elementID) {
+			return nil, fmt.Errorf("gateway or activity '%s' not found", elementID)
+		}
+		target := ""
+		for _, flow := range a.process.ProcessInfo.Elements.SequenceFlows {
+			if flow.SourceRef == elementID && (flow.ID == choice || flow.TargetRef == choice) && a.isDeclared(flow.TargetRef) {
+				target = flow.TargetRef
+				break
+			}
+		}
+		if target == "" {
+			return nil, fmt.Errorf("'%s' has no outgoing flow '%s'", elementID, choice)
+		}
+		targets[elementID] = target
+	}
+
+	return targets, nil
+}
+
+// defaultTarget returns the element a gateway's default flow leads to
+func (a *Analyzer) defaultTarget(gateway *Gateway) (string, bool) {
+	if gateway.DefaultFlow == "" {
+		return "", false
+	}
+	for _, flow := range a.process.ProcessInfo.Elements.SequenceFlows {
+		if flow.ID == gateway.DefaultFlow && flow.SourceRef == gateway.ID && a.isDeclared(flow.TargetRef) {
+			return flow.TargetRef, true
+		}
+	}
+	return "", false
+}
+
+// hasConditionalFlows reports whether any outgoing flow of an element
+// carries a condition expression
+func (a *Analyzer) hasConditionalFlows(id string) bool {
+	for _, flow := range a.process.ProcessInfo.Elements.SequenceFlows {
+		if flow.SourceRef == id && flow.ConditionExpression != nil && a.isDeclared(flow.TargetRef) {
+			return true
+		}
+	}
+	return false
+}
+
+// unconditionalTargets returns the element an activity's default flow leads
+// to or, without one, the targets of its flows that carry no condition
+func (a *Analyzer) unconditionalTargets(id string) []string {
+	var targets []string
+	for _, flow := range a.process.ProcessInfo.Elements.SequenceFlows {
+		if flow.SourceRef != id || !a.isDeclared(flow.TargetRef) {
+			continue
+		}
+		if flow.IsDefault {
+			return []string{flow.TargetRef}
+		}
+		if flow.ConditionExpression == nil {
+			targets = append(targets, flow.TargetRef)
+		}
+	}
+	return targets
+}
+
+func (a *Analyzer) isActivity(id string) bool {
+	for _, act := range a.process.ProcessInfo.Elements.Activities {
+		if act.ID == id {
+			return true
+		}
+	}
+	return false
+}
+
+// FormatSimulationReport creates a human-readable report of a simulation
+func FormatSimulationReport(result *SimulationResult) string {
+	var report strings.Builder
+
+	report.WriteString("=== BPMN Simulation ===\n\n")
+
+	report.WriteString("Activities Visited:\n")
+	if len(result.Activities) > 0 {
+		for i, id := range result.Activities {
+			report.WriteString(fmt.Sprintf("  %d. %s\n", i+1, id))
+		}
+	} else {
+		report.WriteString("  (none)\n")
+	}
+	report.WriteString("\n")
+
+	report.WriteString("End Events Reached:\n")
+	if len(result.EndEvents) > 0 {
+		for _, id := range result.EndEvents {
+			report.WriteString(fmt.Sprintf("  - %s\n", id))
+		}
+	} else {
+		report.WriteString("  (none)\n")
+	}
+	report.WriteString("\n")
+
+	if len(result.Stuck) > 0 {
+		report.WriteString("Stuck Tokens:\n")
+		for _, s := range result.Stuck {
+			report.WriteString(fmt.Sprintf("  ✗ %s: %s\n", s.ElementID, s.Reason))
+		}
+	} else if result.Completed() {
+		report.WriteString("✓ All tokens reached an end event\n")
+	} else {
+		report.WriteString("⚠️  No end event was reached\n")
+	}
+
+	return report.String()
+}
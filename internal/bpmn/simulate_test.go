@@ -0,0 +1,217 @@
+package bpmn
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func newBranchProcess() *Process {
+	// start -> check -> decide -> (approve | reject) -> end
+	return &Process{
+		ProcessInfo: ProcessInfo{
+			ID: "branch",
+			Elements: Elements{
+				Events: []Event{
+					{ID: "start", Type: "startEvent"},
+					{ID: "approved_end", Type: "endEvent"},
+					{ID: "rejected_end", Type: "endEvent"},
+				},
+				Activities: []Activity{
+					{ID: "check", Name: "Check", Type: "userTask"},
+					{ID: "approve", Name: "Approve", Type: "serviceTask"},
+					{ID: "reject", Name: "Reject", Type: "serviceTask"},
+				},
+				Gateways: []Gateway{
+					{ID: "decide", Type: "exclusiveGateway", DefaultFlow: "to_reject"},
+				},
+				SequenceFlows: []SequenceFlow{
+					{ID: "f1", SourceRef: "start", TargetRef: "check"},
+					{ID: "f2", SourceRef: "check", TargetRef: "decide"},
+					{ID: "to_approve", SourceRef: "decide", TargetRef: "approve"},
+					{ID: "to_reject", SourceRef: "decide", TargetRef: "reject"},
+					{ID: "f3", SourceRef: "approve", TargetRef: "approved_end"},
+					{ID: "f4", SourceRef: "reject", TargetRef: "rejected_end"},
+				},
+			},
+		},
+	}
+}
+
+func TestSimulateBranch(t *testing.T) {
+	tests := []struct {
+		name       string
+		decisions  map[string]string
+		activities string
+		end        string
+	}{
+		{"by flow ID", map[string]string{"decide": "to_approve"}, "check,approve", "approved_end"},
+		{"by target", map[string]string{"decide": "reject"}, "check,reject", "rejected_end"},
+		{"default flow", nil, "check,reject", "rejected_end"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := NewAnalyzer(newBranchProcess()).Simulate(tt.decisions)
+			if err != nil {
+				t.Fatalf("Simulate() error = %v", err)
+			}
+			if got := strings.Join(result.Activities, ","); got != tt.activities {
+				t.Errorf("Activities = %s, want %s", got, tt.activities)
+			}
+			if got := strings.Join(result.EndEvents, ","); got != tt.end {
+				t.Errorf("EndEvents = %s, want %s", got, tt.end)
+			}
+			if !result.Completed() {
+				t.Errorf("Expected simulation to complete, stuck: %v", result.Stuck)
+			}
+		})
+	}
+}
+
+func TestSimulateMissingDecision(t *testing.T) {
+	p := newBranchProcess()
+	p.ProcessInfo.Elements.Gateways[0].DefaultFlow = ""
+
+	result, err := NewAnalyzer(p).Simulate(nil)
+	if err != nil {
+		t.Fatalf("Simulate() error = %v", err)
+	}
+	if len(result.Stuck) != 1 || result.Stuck[0].ElementID != "decide" {
+		t.Errorf("Expected token stuck at decide, got %v", result.Stuck)
+	}
+	if result.Completed() {
+		t.Error("Simulation should not complete")
+	}
+}
+
+func TestSimulateInvalidDecision(t *testing.T) {
+	a := NewAnalyzer(newBranchProcess())
+	if _, err := a.Simulate(map[string]string{"decide": "f1"}); err == nil {
+		t.Error("Expected error for a flow that does not leave the gateway")
+	}
+	if _, err := a.Simulate(map[string]string{"missing": "to_approve"}); err == nil {
+		t.Error("Expected error for an unknown gateway")
+	}
+}
+
+func newParallelProcess(joinType string) *Process {
+	// start -> split -> (a, b) -> join -> c -> end
+	return &Process{
+		ProcessInfo: ProcessInfo{
+			ID: "parallel",
+			Elements: Elements{
+				Events: []Event{
+					{ID: "start", Type: "startEvent"},
+					{ID: "end", Type: "endEvent"},
+				},
+				Activities: []Activity{
+					{ID: "a", Name: "A", Type: "task"},
+					{ID: "b", Name: "B", Type: "task"},
+					{ID: "c", Name: "C", Type: "task"},
+				},
+				Gateways: []Gateway{
+					{ID: "split", Type: "parallelGateway"},
+					{ID: "join", Type: joinType},
+				},
+				SequenceFlows: []SequenceFlow{
+					{ID: "f1", SourceRef: "start", TargetRef: "split"},
+					{ID: "f2", SourceRef: "split", TargetRef: "a"},
+					{ID: "f3", SourceRef: "split", TargetRef: "b"},
+					{ID: "f4", SourceRef: "a", TargetRef: "join"},
+					{ID: "f5", SourceRef: "b", TargetRef: "join"},
+					{ID: "f6", SourceRef: "join", TargetRef: "c"},
+					{ID: "f7", SourceRef: "c", TargetRef: "end"},
+				},
+			},
+		},
+	}
+}
+
+func TestSimulateParallelSplitJoin(t *testing.T) {
+	result, err := NewAnalyzer(newParallelProcess("parallelGateway")).Simulate(nil)
+	if err != nil {
+		t.Fatalf("Simulate() error = %v", err)
+	}
+	if got := strings.Join(result.Activities, ","); got != "a,b,c" {
+		t.Errorf("Activities = %s, want a,b,c", got)
+	}
+	if got := strings.Join(result.EndEvents, ","); got != "end" {
+		t.Errorf("EndEvents = %s, want a single token at end", got)
+	}
+	if !result.Completed() {
+		t.Errorf("Expected simulation to complete, stuck: %v", result.Stuck)
+	}
+}
+
+func TestSimulateParallelJoinDeadlock(t *testing.T) {
+	// An exclusive split feeding a parallel join only ever delivers one token
+	p := newParallelProcess("parallelGateway")
+	p.ProcessInfo.Elements.Gateways[0].Type = "exclusiveGateway"
+
+	result, err := NewAnalyzer(p).Simulate(map[string]string{"split": "a"})
+	if err != nil {
+		t.Fatalf("Simulate() error = %v", err)
+	}
+	if len(result.Stuck) != 1 || result.Stuck[0].ElementID != "join" {
+		t.Fatalf("Expected token stuck at join, got %v", result.Stuck)
+	}
+	if !strings.Contains(result.Stuck[0].Reason, "1 of 2") {
+		t.Errorf("Unexpected reason: %s", result.Stuck[0].Reason)
+	}
+	if len(result.EndEvents) != 0 {
+		t.Errorf("Expected no end event, got %v", result.EndEvents)
+	}
+}
+
+func TestSimulateConditionalActivityFlows(t *testing.T) {
+	// task_notify_applicant leaves through two conditional flows, one to
+	// each end event
+	path := filepath.Join("..", "..", "test-data", "exclusive-gateway.json")
+	decisions := map[string]string{"gateway_risk_check": "flow_low_risk"}
+
+	result, err := (&FileSimulator{Decisions: decisions}).SimulateFile(path)
+	if err != nil {
+		t.Fatalf("SimulateFile() error = %v", err)
+	}
+	if len(result.EndEvents) != 0 || len(result.Stuck) != 1 || result.Stuck[0].ElementID != "task_notify_applicant" {
+		t.Errorf("Expected the token to stop at task_notify_applicant, got ends %v, stuck %v", result.EndEvents, result.Stuck)
+	}
+
+	decisions["task_notify_applicant"] = "flow_8"
+	result, err = (&FileSimulator{Decisions: decisions}).SimulateFile(path)
+	if err != nil {
+		t.Fatalf("SimulateFile() error = %v", err)
+	}
+	if got := strings.Join(result.EndEvents, ","); got != "end_approved" {
+		t.Errorf("EndEvents = %s, want exactly end_approved", got)
+	}
+	if !result.Completed() {
+		t.Errorf("Expected simulation to complete, stuck: %v", result.Stuck)
+	}
+}
+
+func TestSimulateConditionalActivityFallback(t *testing.T) {
+	p := newBranchProcess()
+	// check -> approve when approved, otherwise straight to reject
+	flows := p.ProcessInfo.Elements.SequenceFlows
+	flows[1] = SequenceFlow{ID: "f2", SourceRef: "check", TargetRef: "approve", ConditionExpression: &Expression{Body: "approved"}}
+	flows = append(flows, SequenceFlow{ID: "f5", SourceRef: "check", TargetRef: "reject"})
+	p.ProcessInfo.Elements.SequenceFlows = flows
+
+	result, err := NewAnalyzer(p).Simulate(nil)
+	if err != nil {
+		t.Fatalf("Simulate() error = %v", err)
+	}
+	if got := strings.Join(result.EndEvents, ","); got != "rejected_end" {
+		t.Errorf("EndEvents = %s, want the unconditional flow's end", got)
+	}
+
+	result, err = NewAnalyzer(p).Simulate(map[string]string{"check": "approve"})
+	if err != nil {
+		t.Fatalf("Simulate() error = %v", err)
+	}
+	if got := strings.Join(result.EndEvents, ","); got != "approved_end" {
+		t.Errorf("EndEvents = %s, want approved_end", got)
+	}
+}
@@ -9,6 +9,33 @@ type Process struct {
 	Type        string      `json:"$type" validate:"required,eq=bpmn:process"`
 	Version     string      `json:"version" validate:"required,eq=2.0"`
 	ProcessInfo ProcessInfo `json:"process" validate:"required"`
+	Collaboration *Collaboration `json:"collaboration,omitempty"`
+}
+
+// Collaboration describes the pools taking part in a process and the
+// message flows exchanged between them
+type Collaboration struct {
+	ID           string        `json:"id"`
+	Name         string        `json:"name,omitempty"`
+	Participants []Participant `json:"participants,omitempty"`
+	MessageFlows []MessageFlow `json:"messageFlows,omitempty"`
+}
+
+// Participant is a pool in a collaboration, backed by a process
+type Participant struct {
+	ID         string `json:"id"`
+	Name       string `json:"name,omitempty"`
+	ProcessRef string `json:"processRef,omitempty"`
+}
+
+// MessageFlow carries a message from an element in one pool to an element,
+// or the pool itself, in another
+type MessageFlow struct {
+	ID         string `json:"id"`
+	Name       string `json:"name,omitempty"`
+	SourceRef  string `json:"sourceRef"`
+	TargetRef  string `json:"targetRef"`
+	MessageRef string `json:"messageRef,omitempty"`
 }
 
 // ProcessInfo contains the main process information
@@ -44,6 +71,7 @@ type Event struct {
 	Outgoing    []string            `json:"outgoing,omitempty"`
 	IsInterrupt bool                `json:"isInterrupting,omitempty"`
 	Properties  map[string]any      `json:"properties,omitempty"`
+	Position    *Position           `json:"position,omitempty"`
 }
 
 // Activity represents a BPMN activity
@@ -61,9 +89,12 @@ type Activity struct {
 	CompletionQuantity int                 `json:"completionQuantity,omitempty"`
 	StartQuantity      int                 `json:"startQuantity,omitempty"`
 	Script             *Script             `json:"script,omitempty"`
+	CalledElement      string              `json:"calledElement,omitempty"`
 	IOSpecification    *IOSpecification    `json:"ioSpecification,omitempty"`
 	Properties         map[string]any      `json:"properties,omitempty"`
 	BoundaryEvents     []string            `json:"boundaryEventRefs,omitempty"`
+	Position           *Position           `json:"position,omitempty"`
+	Bounds             *Bounds             `json:"bounds,omitempty"`
 }
 
 // Gateway represents a BPMN gateway
@@ -76,6 +107,7 @@ type Gateway struct {
 	Incoming         []string        `json:"incoming,omitempty"`
 	Outgoing         []string        `json:"outgoing,omitempty"`
 	Properties       map[string]any  `json:"properties,omitempty"`
+	Position         *Position       `json:"position,omitempty"`
 }
 
 // SequenceFlow represents a connection between elements
@@ -89,6 +121,20 @@ type SequenceFlow struct {
 	Properties         map[string]any      `json:"properties,omitempty"`
 }
 
+// Position holds an element's diagram coordinates
+type Position struct {
+	X float64 `json:"x"`
+	Y float64 `json:"y"`
+}
+
+// Bounds holds an element's diagram bounding box
+type Bounds struct {
+	X      float64 `json:"x"`
+	Y      float64 `json:"y"`
+	Width  float64 `json:"width"`
+	Height float64 `json:"height"`
+}
+
 // Association represents a non-flow connection
 type Association struct {
 	ID               string              `json:"id" validate:"required"`
@@ -0,0 +1,115 @@
+package mpc
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// ArtifactPath is a single artifact path declared on a node
+type ArtifactPath struct {
+	Field string // key path in the MPC file, e.g. "bpmn", "specs.api", "tests.unit"
+	Path  string
+}
+
+// ArtifactCheck reports the artifact state of a node that needs attention
+type ArtifactCheck struct {
+	NodeID     string
+	Undeclared bool           // node declares no artifact paths at all
+	Missing    []ArtifactPath // declared paths that do not exist on disk
+}
+
+// Paths returns every non-empty artifact path, in declaration order. Fields
+// are labelled with the keys the MPC file uses, so the properties and tests
+// mappings appear under "properties" and "tests".
+func (a *Artifacts) Paths() []ArtifactPath {
+	if a == nil {
+		return nil
+	}
+
+	var paths []ArtifactPath
+	add := func(field, path string) {
+		if path != "" {
+			paths = append(paths, ArtifactPath{Field: field, Path: path})
+		}
+	}
+
+	add("bpmn", a.BPMN)
+	add("spec", a.Spec)
+	add("tests", a.Tests)
+	add("properties", a.Properties)
+
+	if p := a.PropertiesStruct; p != nil {
+		add("properties.invariants", p.Invariants)
+		add("properties.state_properties", p.StateProperties)
+		add("properties.generators", p.Generators)
+	}
+	if s := a.SpecsStruct; s != nil {
+		add("specs.api", s.API)
+		add("specs.models", s.Models)
+		add("specs.schemas", s.Schemas)
+	}
+	if t := a.TestsStruct; t != nil {
+		add("tests.property", t.Property)
+		add("tests.deterministic", t.Deterministic)
+		add("tests.fuzz", t.Fuzz)
+		add("tests.contract", t.Contract)
+		add("tests.unit", t.Unit)
+		add("tests.integration", t.Integration)
+		add("tests.e2e", t.E2E)
+	}
+
+	return paths
+}
+
+// VerifyArtifacts checks that every declared artifact exists on disk.
+// Relative paths are resolved against baseDir, normally the directory of the
+// MPC file. Paths containing wildcards pass when they match at least one file.
+// Only nodes with missing or undeclared artifacts are returned.
+func VerifyArtifacts(m *MPC, baseDir string) []ArtifactCheck {
+	var checks []ArtifactCheck
+
+	for i := range m.Nodes {
+		if check := m.Nodes[i].CheckArtifacts(baseDir); check != nil {
+			checks = append(checks, *check)
+		}
+	}
+
+	return checks
+}
+
+// CheckArtifacts checks the node's declared artifacts the way VerifyArtifacts
+// does. It returns nil when the node declares artifacts and all of them exist.
+func (n *Node) CheckArtifacts(baseDir string) *ArtifactCheck {
+	paths := n.Artifacts.Paths()
+	if len(paths) == 0 {
+		return &ArtifactCheck{NodeID: n.ID, Undeclared: true}
+	}
+
+	var missing []ArtifactPath
+	for _, p := range paths {
+		if !artifactExists(resolveArtifactPath(baseDir, p.Path)) {
+			missing = append(missing, p)
+		}
+	}
+	if len(missing) > 0 {
+		return &ArtifactCheck{NodeID: n.ID, Missing: missing}
+	}
+	return nil
+}
+
+func resolveArtifactPath(baseDir, path string) string {
+	if filepath.IsAbs(path) {
+		return path
+	}
+	return filepath.Join(baseDir, path)
+}
+
+func artifactExists(path string) bool {
+	if strings.ContainsAny(path, "*?[") {
+		matches, err := filepath.Glob(path)
+		return err == nil && len(matches) > 0
+	}
+	_, err := os.Stat(path)
+	return err == nil
+}
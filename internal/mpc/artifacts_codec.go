@@ -0,0 +1,155 @@
+package mpc
+
+import (
+	"encoding/json"
+
+	"gopkg.in/yaml.v3"
+)
+
+// The "properties" and "tests" keys are overloaded: the old format stores a
+// single path string and the enriched format stores a mapping of paths.
+// Artifacts decodes either shape and encodes each field back in the shape it
+// was read in. Files written before this used "properties_struct" and
+// "tests_struct" for the mappings; those keys are still read, and are written
+// only when a node carries both the string and the mapping form.
+
+// artifactsDocument is the encoded layout of Artifacts
+type artifactsDocument struct {
+	BPMN             string              `json:"bpmn,omitempty" yaml:"bpmn,omitempty"`
+	Properties       interface{}         `json:"properties,omitempty" yaml:"properties,omitempty"`
+	PropertiesStruct *ArtifactProperties `json:"properties_struct,omitempty" yaml:"properties_struct,omitempty"`
+	Spec             string              `json:"spec,omitempty" yaml:"spec,omitempty"`
+	SpecsStruct      *ArtifactSpecs      `json:"specs,omitempty" yaml:"specs,omitempty"`
+	Tests            interface{}         `json:"tests,omitempty" yaml:"tests,omitempty"`
+	TestsStruct      *ArtifactTests      `json:"tests_struct,omitempty" yaml:"tests_struct,omitempty"`
+}
+
+func (a Artifacts) document() artifactsDocument {
+	doc := artifactsDocument{
+		BPMN:        a.BPMN,
+		Spec:        a.Spec,
+		SpecsStruct: a.SpecsStruct,
+	}
+
+	switch {
+	case a.PropertiesStruct != nil && a.Properties == "":
+		doc.Properties = a.PropertiesStruct
+	case a.PropertiesStruct != nil:
+		doc.Properties = a.Properties
+		doc.PropertiesStruct = a.PropertiesStruct
+	case a.Properties != "":
+		doc.Properties = a.Properties
+	}
+
+	switch {
+	case a.TestsStruct != nil && a.Tests == "":
+		doc.Tests = a.TestsStruct
+	case a.TestsStruct != nil:
+		doc.Tests = a.Tests
+		doc.TestsStruct = a.TestsStruct
+	case a.Tests != "":
+		doc.Tests = a.Tests
+	}
+
+	return doc
+}
+
+// MarshalJSON encodes artifacts in the file format described above
+func (a Artifacts) MarshalJSON() ([]byte, error) {
+	return json.Marshal(a.document())
+}
+
+// MarshalYAML encodes artifacts in the file format described above
+func (a Artifacts) MarshalYAML() (interface{}, error) {
+	return a.document(), nil
+}
+
+// UnmarshalJSON decodes either the old or the enriched artifact format
+func (a *Artifacts) UnmarshalJSON(data []byte) error {
+	var raw struct {
+		artifactsDocument
+		Properties json.RawMessage `json:"properties,omitempty"`
+		Tests      json.RawMessage `json:"tests,omitempty"`
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	*a = Artifacts{
+		BPMN:             raw.BPMN,
+		Spec:             raw.Spec,
+		PropertiesStruct: raw.PropertiesStruct,
+		SpecsStruct:      raw.SpecsStruct,
+		TestsStruct:      raw.TestsStruct,
+	}
+
+	if len(raw.Properties) > 0 && string(raw.Properties) != "null" {
+		if raw.Properties[0] == '"' {
+			if err := json.Unmarshal(raw.Properties, &a.Properties); err != nil {
+				return err
+			}
+		} else if err := json.Unmarshal(raw.Properties, &a.PropertiesStruct); err != nil {
+			return err
+		}
+	}
+
+	if len(raw.Tests) > 0 && string(raw.Tests) != "null" {
+		if raw.Tests[0] == '"' {
+			if err := json.Unmarshal(raw.Tests, &a.Tests); err != nil {
+				return err
+			}
+		} else if err := json.Unmarshal(raw.Tests, &a.TestsStruct); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// UnmarshalYAML decodes either the old or the enriched artifact format
+func (a *Artifacts) UnmarshalYAML(value *yaml.Node) error {
+	var raw struct {
+		BPMN             string              `yaml:"bpmn"`
+		Properties       yaml.Node           `yaml:"properties"`
+		PropertiesStruct *ArtifactProperties `yaml:"properties_struct"`
+		Spec             string              `yaml:"spec"`
+		SpecsStruct      *ArtifactSpecs      `yaml:"specs"`
+		Tests            yaml.Node           `yaml:"tests"`
+		TestsStruct      *ArtifactTests      `yaml:"tests_struct"`
+	}
+	if err := value.Decode(&raw); err != nil {
+		return err
+	}
+
+	*a = Artifacts{
+		BPMN:             raw.BPMN,
+		Spec:             raw.Spec,
+		PropertiesStruct: raw.PropertiesStruct,
+		SpecsStruct:      raw.SpecsStruct,
+		TestsStruct:      raw.TestsStruct,
+	}
+
+	switch raw.Properties.Kind {
+	case yaml.ScalarNode:
+		if err := raw.Properties.Decode(&a.Properties); err != nil {
+			return err
+		}
+	case yaml.MappingNode:
+		if err := raw.Properties.Decode(&a.PropertiesStruct); err != nil {
+			return err
+		}
+	}
+
+	switch raw.Tests.Kind {
+	case yaml.ScalarNode:
+		if err := raw.Tests.Decode(&a.Tests); err != nil {
+			return err
+		}
+	case yaml.MappingNode:
+		if err := raw.Tests.Decode(&a.TestsStruct); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,56 @@
+package mpc
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestVerifyArtifacts(t *testing.T) {
+	dir := t.TempDir()
+	for _, name := range []string{"process.json", "api.yaml", "unit_a_test.go"} {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte("{}"), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	m := &MPC{
+		Nodes: []Node{
+			{ID: "present", Artifacts: &Artifacts{
+				BPMN:        "process.json",
+				SpecsStruct: &ArtifactSpecs{API: "api.yaml"},
+				TestsStruct: &ArtifactTests{Unit: "unit_*_test.go"},
+			}},
+			{ID: "missing", Artifacts: &Artifacts{
+				BPMN:        "process.json",
+				Spec:        "deleted.yaml",
+				TestsStruct: &ArtifactTests{Fuzz: "fuzz_*_test.go"},
+			}},
+			{ID: "absolute", Artifacts: &Artifacts{BPMN: filepath.Join(dir, "process.json")}},
+			{ID: "undeclared"},
+			{ID: "empty", Artifacts: &Artifacts{}},
+		},
+	}
+
+	checks := VerifyArtifacts(m, dir)
+	if len(checks) != 3 {
+		t.Fatalf("VerifyArtifacts() returned %d checks, want 3: %+v", len(checks), checks)
+	}
+
+	missing := checks[0]
+	if missing.NodeID != "missing" || missing.Undeclared {
+		t.Fatalf("Unexpected check: %+v", missing)
+	}
+	if len(missing.Missing) != 2 ||
+		missing.Missing[0] != (ArtifactPath{Field: "spec", Path: "deleted.yaml"}) ||
+		missing.Missing[1] != (ArtifactPath{Field: "tests.fuzz", Path: "fuzz_*_test.go"}) {
+		t.Errorf("Missing = %+v", missing.Missing)
+	}
+
+	for i, id := range []string{"undeclared", "empty"} {
+		check := checks[i+1]
+		if check.NodeID != id || !check.Undeclared || len(check.Missing) != 0 {
+			t.Errorf("Expected %s to be reported as undeclared, got %+v", id, check)
+		}
+	}
+}
@@ -0,0 +1,99 @@
+package mpc
+
+import "time"
+
+// RemainingSubtasks returns the number of incomplete subtasks on a node.
+// Completed nodes have no remaining work regardless of subtask state.
+func (n *Node) RemainingSubtasks() int {
+	if n.Status == StatusCompleted {
+		return 0
+	}
+	return len(n.Subtasks) - n.GetCompletedSubtaskCount()
+}
+
+// CriticalPath returns the chain of nodes, following Downstream links, with
+// the most remaining subtasks. Ties go to the longer chain, then to the
+// lower node ID, so the result is stable. Nodes that are part of a
+// dependency cycle are not revisited.
+func (m *MPC) CriticalPath() []string {
+	memo := make(map[string][]string)
+	weight := make(map[string]int)
+	visiting := make(map[string]bool)
+
+	var longest func(id string) []string
+	longest = func(id string) []string {
+		if path, ok := memo[id]; ok {
+			return path
+		}
+		node := m.GetNodeByID(id)
+		if node == nil || visiting[id] {
+			return nil
+		}
+		visiting[id] = true
+
+		var best []string
+		bestWeight := -1
+		for _, next := range node.Downstream {
+			path := longest(next)
+			if path == nil {
+				continue
+			}
+			if w := weight[next]; longerPath(w, path, bestWeight, best) {
+				best, bestWeight = path, w
+			}
+		}
+
+		visiting[id] = false
+		memo[id] = append([]string{id}, best...)
+		weight[id] = node.RemainingSubtasks()
+		if best != nil {
+			weight[id] += bestWeight
+		}
+		return memo[id]
+	}
+
+	var critical []string
+	criticalWeight := -1
+	for _, node := range m.Nodes {
+		path := longest(node.ID)
+		if w := weight[node.ID]; longerPath(w, path, criticalWeight, critical) {
+			critical, criticalWeight = path, w
+		}
+	}
+
+	return critical
+}
+
+// longerPath reports whether a path with weight w should replace the current best
+func longerPath(w int, path []string, bestWeight int, best []string) bool {
+	if w != bestWeight {
+		return w > bestWeight
+	}
+	if len(path) != len(best) {
+		return len(path) > len(best)
+	}
+	return path[0] < best[0]
+}
+
+// Estimate is a projection of the work left on a plan
+type Estimate struct {
+	CriticalPath      []string      // nodes on the critical path
+	RemainingSubtasks int           // incomplete subtasks along the critical path
+	Remaining         time.Duration // RemainingSubtasks at the per-subtask duration
+}
+
+// Estimate projects the time left on the plan by multiplying the incomplete
+// subtasks along the critical path by the per-subtask duration
+func (m *MPC) Estimate(perSubtaskDuration time.Duration) Estimate {
+	e := Estimate{CriticalPath: m.CriticalPath()}
+	for _, id := range e.CriticalPath {
+		e.RemainingSubtasks += m.GetNodeByID(id).RemainingSubtasks()
+	}
+	e.Remaining = time.Duration(e.RemainingSubtasks) * perSubtaskDuration
+	return e
+}
+
+// EstimateRemaining returns the projected time left on the plan
+func (m *MPC) EstimateRemaining(perSubtaskDuration time.Duration) time.Duration {
+	return m.Estimate(perSubtaskDuration).Remaining
+}
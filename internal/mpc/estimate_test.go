@@ -0,0 +1,64 @@
+package mpc
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func subtasks(done, total int) []Subtask {
+	s := make([]Subtask, total)
+	for i := 0; i < done; i++ {
+		s[i].Completed = true
+	}
+	return s
+}
+
+func TestEstimateRemaining(t *testing.T) {
+	// setup -> model -> api -> tests
+	//       \-> docs ------/
+	m := &MPC{
+		EntryNode: "setup",
+		Nodes: []Node{
+			{ID: "setup", Status: StatusCompleted, Subtasks: subtasks(2, 3), Downstream: []string{"model", "docs"}},
+			{ID: "model", Status: StatusInProgress, Subtasks: subtasks(1, 3), Downstream: []string{"api"}},
+			{ID: "docs", Status: StatusReady, Subtasks: subtasks(0, 1), Downstream: []string{"api"}},
+			{ID: "api", Status: StatusBlocked, Subtasks: subtasks(0, 4), Downstream: []string{"tests"}},
+			{ID: "tests", Status: StatusBlocked},
+		},
+	}
+
+	if got := strings.Join(m.CriticalPath(), ","); got != "setup,model,api,tests" {
+		t.Errorf("CriticalPath() = %s, want setup,model,api,tests", got)
+	}
+
+	// model has 2 remaining, api 4; setup is completed and tests has no subtasks
+	if got := m.EstimateRemaining(30 * time.Minute); got != 3*time.Hour {
+		t.Errorf("EstimateRemaining() = %v, want 3h", got)
+	}
+
+	e := m.Estimate(30 * time.Minute)
+	if e.RemainingSubtasks != 6 || e.Remaining != 3*time.Hour || strings.Join(e.CriticalPath, ",") != "setup,model,api,tests" {
+		t.Errorf("Estimate() = %+v", e)
+	}
+}
+
+func TestEstimateRemainingNoWork(t *testing.T) {
+	m := &MPC{
+		Nodes: []Node{
+			{ID: "a", Status: StatusCompleted, Subtasks: subtasks(1, 1), Downstream: []string{"b"}},
+			{ID: "b", Status: StatusReady},
+		},
+	}
+
+	if got := m.EstimateRemaining(time.Hour); got != 0 {
+		t.Errorf("EstimateRemaining() = %v, want 0", got)
+	}
+	if got := strings.Join(m.CriticalPath(), ","); got != "a,b" {
+		t.Errorf("CriticalPath() = %s, want a,b", got)
+	}
+
+	if got := (&MPC{}).EstimateRemaining(time.Hour); got != 0 {
+		t.Errorf("EstimateRemaining() on empty plan = %v, want 0", got)
+	}
+}
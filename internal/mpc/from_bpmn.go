@@ -0,0 +1,153 @@
+package mpc
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/mattbarlow-sg/workflows/internal/bpmn"
+)
+
+// GenerateMPCFromProcess bootstraps an MPC plan from a BPMN process. Each
+// activity becomes a node, and a node's downstream list holds the activities
+// reached by following sequence flows through gateways and events. The
+// entry node is the first activity reached from a start event; nodes with
+// no upstream activity start Ready and the rest Blocked.
+//
+// Only the node graph is derived. Plan metadata, subtasks, acceptance
+// criteria and artifacts are left empty to be filled in before the plan is
+// validated.
+func GenerateMPCFromProcess(p *bpmn.Process) *MPC {
+	info := p.ProcessInfo
+	elements := info.Elements
+
+	nodeIDs := mpcNodeIDs(elements.Activities)
+
+	outgoing := make(map[string][]string)
+	for _, flow := range elements.SequenceFlows {
+		outgoing[flow.SourceRef] = append(outgoing[flow.SourceRef], flow.TargetRef)
+	}
+	// Flows leaving a boundary event continue from the activity it is attached to
+	boundary := make(map[string][]string)
+	for _, e := range elements.Events {
+		if e.Type == "boundaryEvent" && e.AttachedTo != "" {
+			boundary[e.AttachedTo] = append(boundary[e.AttachedTo], e.ID)
+		}
+	}
+
+	// nextActivities follows flows from the given elements, passing through
+	// anything that is not an activity
+	nextActivities := func(from []string) []string {
+		var found []string
+		seen := make(map[string]bool)
+		queue := append([]string{}, from...)
+		for len(queue) > 0 {
+			current := queue[0]
+			queue = queue[1:]
+			for _, target := range outgoing[current] {
+				if seen[target] {
+					continue
+				}
+				seen[target] = true
+				if _, ok := nodeIDs[target]; ok {
+					found = append(found, target)
+					continue
+				}
+				queue = append(queue, target)
+			}
+		}
+		return found
+	}
+
+	m := &MPC{
+		PlanName: info.Name,
+		Context:  Context{BusinessGoal: info.Description},
+		Nodes:    make([]Node, 0, len(elements.Activities)),
+	}
+
+	hasUpstream := make(map[string]bool)
+	for _, act := range elements.Activities {
+		downstream := []string{}
+		seen := make(map[string]bool)
+		for _, next := range nextActivities(append([]string{act.ID}, boundary[act.ID]...)) {
+			id := nodeIDs[next]
+			if !seen[id] {
+				seen[id] = true
+				downstream = append(downstream, id)
+			}
+			hasUpstream[id] = true
+		}
+		sort.Strings(downstream)
+
+		description := act.Name
+		if description == "" {
+			description = act.ID
+		}
+		detailed := act.Description
+		if detailed == "" {
+			detailed = description
+		}
+
+		m.Nodes = append(m.Nodes, Node{
+			ID:                  nodeIDs[act.ID],
+			Description:         description,
+			DetailedDescription: detailed,
+			Subtasks:            []Subtask{},
+			AcceptanceCriteria:  []string{},
+			Downstream:          downstream,
+		})
+	}
+
+	for i := range m.Nodes {
+		m.Nodes[i].Status = StatusReady
+		if hasUpstream[m.Nodes[i].ID] {
+			m.Nodes[i].Status = StatusBlocked
+		}
+	}
+
+	for _, e := range elements.Events {
+		if e.Type != "startEvent" {
+			continue
+		}
+		if next := nextActivities([]string{e.ID}); len(next) > 0 {
+			m.EntryNode = nodeIDs[next[0]]
+			break
+		}
+	}
+	if m.EntryNode == "" && len(m.Nodes) > 0 {
+		m.EntryNode = m.Nodes[0].ID
+	}
+
+	return m
+}
+
+// mpcNodeIDs maps activity IDs to MPC node IDs, which are limited to
+// lowercase letters, digits and hyphens. Collisions get a numeric suffix.
+func mpcNodeIDs(activities []bpmn.Activity) map[string]string {
+	ids := make(map[string]string, len(activities))
+	used := make(map[string]bool, len(activities))
+
+	for _, act := range activities {
+		var sb strings.Builder
+		for _, r := range strings.ToLower(act.ID) {
+			if (r >= 'a' && r <= 'z') || (r >= '0' && r <= '9') {
+				sb.WriteRune(r)
+			} else if !strings.HasSuffix(sb.String(), "-") {
+				sb.WriteRune('-')
+			}
+		}
+		base := strings.Trim(sb.String(), "-")
+		if base == "" {
+			base = "node"
+		}
+
+		id := base
+		for n := 2; used[id]; n++ {
+			id = fmt.Sprintf("%s-%d", base, n)
+		}
+		used[id] = true
+		ids[act.ID] = id
+	}
+
+	return ids
+}
@@ -0,0 +1,120 @@
+package mpc
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/mattbarlow-sg/workflows/internal/bpmn"
+)
+
+func TestGenerateMPCFromProcessLinear(t *testing.T) {
+	p := &bpmn.Process{
+		ProcessInfo: bpmn.ProcessInfo{
+			ID:   "order",
+			Name: "Order Process",
+			Elements: bpmn.Elements{
+				Events: []bpmn.Event{
+					{ID: "end", Type: "endEvent"},
+					{ID: "start", Type: "startEvent"},
+				},
+				Activities: []bpmn.Activity{
+					{ID: "Ship_Order", Name: "Ship order", Type: "serviceTask"},
+					{ID: "receive_order", Name: "Receive order", Type: "userTask", Description: "Capture the order details"},
+					{ID: "check_stock", Name: "Check stock", Type: "serviceTask"},
+				},
+				SequenceFlows: []bpmn.SequenceFlow{
+					{ID: "f1", SourceRef: "start", TargetRef: "receive_order"},
+					{ID: "f2", SourceRef: "receive_order", TargetRef: "check_stock"},
+					{ID: "f3", SourceRef: "check_stock", TargetRef: "Ship_Order"},
+					{ID: "f4", SourceRef: "Ship_Order", TargetRef: "end"},
+				},
+			},
+		},
+	}
+
+	m := GenerateMPCFromProcess(p)
+
+	if m.EntryNode != "receive-order" {
+		t.Errorf("EntryNode = %s, want receive-order", m.EntryNode)
+	}
+	if m.PlanName != "Order Process" {
+		t.Errorf("PlanName = %s", m.PlanName)
+	}
+	if len(m.Nodes) != 3 {
+		t.Fatalf("Expected 3 nodes, got %d", len(m.Nodes))
+	}
+
+	want := map[string]struct {
+		downstream  string
+		status      string
+		description string
+	}{
+		"ship-order":    {"", StatusBlocked, "Ship order"},
+		"receive-order": {"check-stock", StatusReady, "Receive order"},
+		"check-stock":   {"ship-order", StatusBlocked, "Check stock"},
+	}
+	for _, node := range m.Nodes {
+		w, ok := want[node.ID]
+		if !ok {
+			t.Errorf("Unexpected node %s", node.ID)
+			continue
+		}
+		if got := strings.Join(node.Downstream, ","); got != w.downstream {
+			t.Errorf("%s downstream = %q, want %q", node.ID, got, w.downstream)
+		}
+		if node.Status != w.status {
+			t.Errorf("%s status = %s, want %s", node.ID, node.Status, w.status)
+		}
+		if node.Description != w.description {
+			t.Errorf("%s description = %s, want %s", node.ID, node.Description, w.description)
+		}
+		if node.Artifacts != nil {
+			t.Errorf("%s should have no artifacts", node.ID)
+		}
+	}
+
+	if got := m.GetNodeByID("receive-order").DetailedDescription; got != "Capture the order details" {
+		t.Errorf("DetailedDescription = %s", got)
+	}
+}
+
+func TestGenerateMPCFromProcessThroughGateways(t *testing.T) {
+	// start -> a -> split -> (b, c) -> join -> d -> end
+	p := &bpmn.Process{
+		ProcessInfo: bpmn.ProcessInfo{
+			Elements: bpmn.Elements{
+				Events: []bpmn.Event{{ID: "start", Type: "startEvent"}, {ID: "end", Type: "endEvent"}},
+				Activities: []bpmn.Activity{
+					{ID: "a", Name: "A"}, {ID: "b", Name: "B"}, {ID: "c", Name: "C"}, {ID: "d", Name: "D"},
+				},
+				Gateways: []bpmn.Gateway{
+					{ID: "split", Type: "parallelGateway"},
+					{ID: "join", Type: "parallelGateway"},
+				},
+				SequenceFlows: []bpmn.SequenceFlow{
+					{ID: "f1", SourceRef: "start", TargetRef: "a"},
+					{ID: "f2", SourceRef: "a", TargetRef: "split"},
+					{ID: "f3", SourceRef: "split", TargetRef: "c"},
+					{ID: "f4", SourceRef: "split", TargetRef: "b"},
+					{ID: "f5", SourceRef: "b", TargetRef: "join"},
+					{ID: "f6", SourceRef: "c", TargetRef: "join"},
+					{ID: "f7", SourceRef: "join", TargetRef: "d"},
+					{ID: "f8", SourceRef: "d", TargetRef: "end"},
+				},
+			},
+		},
+	}
+
+	m := GenerateMPCFromProcess(p)
+	if got := strings.Join(m.GetNodeByID("a").Downstream, ","); got != "b,c" {
+		t.Errorf("a downstream = %s, want b,c", got)
+	}
+	for _, id := range []string{"b", "c"} {
+		if got := strings.Join(m.GetNodeByID(id).Downstream, ","); got != "d" {
+			t.Errorf("%s downstream = %s, want d", id, got)
+		}
+	}
+	if m.EntryNode != "a" {
+		t.Errorf("EntryNode = %s, want a", m.EntryNode)
+	}
+}
@@ -0,0 +1,85 @@
+package mpc
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// statusColors maps node status to a Graphviz fill color
+var statusColors = map[string]string{
+	StatusReady:      "lightblue",
+	StatusInProgress: "gold",
+	StatusBlocked:    "lightcoral",
+	StatusCompleted:  "palegreen",
+}
+
+// renderDOT renders the node dependency graph as a Graphviz digraph. Nodes
+// are filled by status, the entry node is drawn with a double border, and
+// nodes and edges are emitted in sorted order so output is stable.
+func (r *Renderer) renderDOT() (string, error) {
+	var sb strings.Builder
+
+	sb.WriteString(fmt.Sprintf("digraph %s {\n", dotQuote(r.mpc.PlanID)))
+	sb.WriteString("  rankdir=LR;\n")
+	sb.WriteString("  node [shape=box, style=\"rounded,filled\"];\n\n")
+
+	nodes := r.sortedNodes()
+	for _, node := range nodes {
+		color, ok := statusColors[node.Status]
+		if !ok {
+			color = "white"
+		}
+		attrs := fmt.Sprintf("label=%s, fillcolor=%s", dotQuote(node.ID+"\n"+node.Status), color)
+		if node.ID == r.mpc.EntryNode {
+			attrs += ", peripheries=2, penwidth=2"
+		}
+		sb.WriteString(fmt.Sprintf("  %s [%s];\n", dotQuote(node.ID), attrs))
+	}
+
+	sb.WriteString("\n")
+	for _, node := range nodes {
+		downstream := append([]string{}, node.Downstream...)
+		sort.Strings(downstream)
+		for _, target := range downstream {
+			sb.WriteString(fmt.Sprintf("  %s -> %s;\n", dotQuote(node.ID), dotQuote(target)))
+		}
+	}
+
+	sb.WriteString("}\n")
+	return sb.String(), nil
+}
+
+// RenderGraphText renders the node dependency graph as a sorted edge list
+func (r *Renderer) RenderGraphText() string {
+	var sb strings.Builder
+
+	sb.WriteString(fmt.Sprintf("Entry: %s\n", r.mpc.EntryNode))
+	for _, node := range r.sortedNodes() {
+		sb.WriteString(fmt.Sprintf("%s [%s]", node.ID, node.Status))
+		if len(node.Downstream) > 0 {
+			downstream := append([]string{}, node.Downstream...)
+			sort.Strings(downstream)
+			sb.WriteString(" -> " + strings.Join(downstream, ", "))
+		}
+		sb.WriteString("\n")
+	}
+
+	return sb.String()
+}
+
+func (r *Renderer) sortedNodes() []Node {
+	nodes := append([]Node{}, r.mpc.Nodes...)
+	sort.Slice(nodes, func(i, j int) bool {
+		return nodes[i].ID < nodes[j].ID
+	})
+	return nodes
+}
+
+// dotQuote quotes a string as a DOT identifier
+func dotQuote(s string) string {
+	s = strings.ReplaceAll(s, `\`, `\\`)
+	s = strings.ReplaceAll(s, `"`, `\"`)
+	s = strings.ReplaceAll(s, "\n", `\n`)
+	return `"` + s + `"`
+}
@@ -0,0 +1,50 @@
+package mpc
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestRenderDOT(t *testing.T) {
+	m := &MPC{
+		PlanID:    "plan",
+		EntryNode: "setup",
+		Nodes: []Node{
+			{ID: "test", Status: StatusBlocked},
+			{ID: "setup", Status: StatusCompleted, Downstream: []string{"model", "api"}},
+			{ID: "model", Status: StatusInProgress, Downstream: []string{"test"}},
+			{ID: "api", Status: StatusReady, Downstream: []string{"test"}},
+		},
+	}
+
+	out, err := NewRenderer(m).Render("dot")
+	if err != nil {
+		t.Fatalf("Render(dot) error = %v", err)
+	}
+
+	want := []string{
+		`"api" [label="api\nReady", fillcolor=lightblue];`,
+		`"model" [label="model\nIn Progress", fillcolor=gold];`,
+		`"setup" [label="setup\nCompleted", fillcolor=palegreen, peripheries=2, penwidth=2];`,
+		`"test" [label="test\nBlocked", fillcolor=lightcoral];`,
+		`"api" -> "test";`,
+		`"model" -> "test";`,
+		`"setup" -> "api";`,
+		`"setup" -> "model";`,
+	}
+
+	// Declarations must appear in exactly this order
+	pos := 0
+	for _, line := range want {
+		i := strings.Index(out[pos:], line)
+		if i < 0 {
+			t.Fatalf("Output missing %q after offset %d:\n%s", line, pos, out)
+		}
+		pos += i + len(line)
+	}
+
+	again, _ := NewRenderer(m).Render("dot")
+	if again != out {
+		t.Error("DOT output is not stable across renders")
+	}
+}
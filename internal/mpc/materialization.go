@@ -0,0 +1,62 @@
+package mpc
+
+import "math"
+
+// MaterializationWeights controls how RecalculateMaterialization combines a
+// node's progress into its materialization score:
+//
+//	score = (Subtasks*completedFraction + Artifacts*presentFraction) / (Subtasks + Artifacts)
+//
+// completedFraction is the share of completed subtasks (1 for a Completed
+// node without subtasks) and presentFraction is the share of the node's
+// declared artifact paths that exist on disk, as checked by CheckArtifacts.
+// A node that declares no artifacts is scored on its subtasks alone, leaving
+// the Artifacts weight out of the total. Scores are rounded to two decimals.
+type MaterializationWeights struct {
+	Subtasks  float64
+	Artifacts float64
+}
+
+// DefaultMaterializationWeights weighs subtask completion at 80% and
+// present artifacts at 20%
+var DefaultMaterializationWeights = MaterializationWeights{Subtasks: 0.8, Artifacts: 0.2}
+
+// RecalculateMaterialization derives the node's materialization score from
+// its subtasks and artifacts using DefaultMaterializationWeights. Relative
+// artifact paths are resolved against baseDir, normally the directory of the
+// MPC file.
+func (n *Node) RecalculateMaterialization(baseDir string) {
+	n.RecalculateMaterializationWith(DefaultMaterializationWeights, baseDir)
+}
+
+// RecalculateMaterializationWith derives the node's materialization score
+// using the given weights. Weights that sum to zero leave the score as is.
+func (n *Node) RecalculateMaterializationWith(w MaterializationWeights, baseDir string) {
+	declared := len(n.Artifacts.Paths())
+	if declared == 0 {
+		w.Artifacts = 0
+	}
+	total := w.Subtasks + w.Artifacts
+	if total <= 0 {
+		return
+	}
+
+	fraction := 0.0
+	if len(n.Subtasks) > 0 {
+		fraction = float64(n.GetCompletedSubtaskCount()) / float64(len(n.Subtasks))
+	} else if n.Status == StatusCompleted {
+		fraction = 1
+	}
+
+	artifacts := 0.0
+	if declared > 0 {
+		missing := 0
+		if check := n.CheckArtifacts(baseDir); check != nil {
+			missing = len(check.Missing)
+		}
+		artifacts = float64(declared-missing) / float64(declared)
+	}
+
+	score := (w.Subtasks*fraction + w.Artifacts*artifacts) / total
+	n.Materialization = math.Round(score*100) / 100
+}
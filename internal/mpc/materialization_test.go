@@ -0,0 +1,78 @@
+package mpc
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestRecalculateMaterialization(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, "process.json"), []byte("{}"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	withArtifacts := &Artifacts{BPMN: "process.json"}
+
+	tests := []struct {
+		name string
+		node Node
+		want float64
+	}{
+		{"all subtasks and artifacts", Node{Subtasks: subtasks(3, 3), Artifacts: withArtifacts}, 1.0},
+		{"all subtasks without artifacts", Node{Subtasks: subtasks(3, 3)}, 1.0},
+		{"partial without artifacts", Node{Subtasks: subtasks(1, 2)}, 0.5},
+		{"empty artifacts count as undeclared", Node{Subtasks: subtasks(3, 3), Artifacts: &Artifacts{}}, 1.0},
+		{"missing artifacts do not count", Node{Subtasks: subtasks(3, 3), Artifacts: &Artifacts{BPMN: "deleted.json"}}, 0.8},
+		{"some artifacts missing", Node{Subtasks: subtasks(3, 3), Artifacts: &Artifacts{BPMN: "process.json", Spec: "deleted.yaml"}}, 0.9},
+		{"partial with artifacts", Node{Subtasks: subtasks(1, 2), Artifacts: withArtifacts}, 0.6},
+		{"nothing done", Node{Subtasks: subtasks(0, 3)}, 0},
+		{"completed without subtasks", Node{Status: StatusCompleted, Artifacts: withArtifacts}, 1.0},
+		{"completed without subtasks or artifacts", Node{Status: StatusCompleted}, 1.0},
+		{"thirds are rounded", Node{Subtasks: subtasks(1, 3), Artifacts: withArtifacts}, 0.47},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			node := tt.node
+			node.Materialization = 0.5
+			node.RecalculateMaterialization(dir)
+			if node.Materialization != tt.want {
+				t.Errorf("Materialization = %v, want %v", node.Materialization, tt.want)
+			}
+		})
+	}
+}
+
+func TestRecalculateMaterializationWith(t *testing.T) {
+	node := Node{Subtasks: subtasks(1, 2), Materialization: 0.9}
+
+	node.RecalculateMaterializationWith(MaterializationWeights{Subtasks: 1}, "")
+	if node.Materialization != 0.5 {
+		t.Errorf("Subtask-only weights: Materialization = %v, want 0.5", node.Materialization)
+	}
+
+	node.RecalculateMaterializationWith(MaterializationWeights{}, "")
+	if node.Materialization != 0.5 {
+		t.Errorf("Zero weights should leave the score unchanged, got %v", node.Materialization)
+	}
+}
+
+func TestCompleteNodeCountsPresentArtifacts(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, "process.json"), []byte("{}"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	m := &MPC{Nodes: []Node{
+		{ID: "present", Subtasks: subtasks(0, 1), Artifacts: &Artifacts{BPMN: "process.json"}},
+		{ID: "missing", Subtasks: subtasks(0, 1), Artifacts: &Artifacts{BPMN: "deleted.json"}},
+	}}
+
+	for id, want := range map[string]float64{"present": 1.0, "missing": 0.8} {
+		if err := m.CompleteNode(id, dir); err != nil {
+			t.Fatal(err)
+		}
+		if got := m.GetNodeByID(id).Materialization; got != want {
+			t.Errorf("%s: Materialization = %v, want %v", id, got, want)
+		}
+	}
+}
@@ -0,0 +1,293 @@
+package mpc
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// CompleteSubtask marks the subtask at a 1-based position complete and
+// updates the node's status and materialization, looking up artifacts
+// relative to baseDir. Subtasks whose prerequisites are still incomplete are
+// rejected.
+func (m *MPC) CompleteSubtask(nodeID string, position int, baseDir string) error {
+	node := m.GetNodeByID(nodeID)
+	if node == nil {
+		return fmt.Errorf("node '%s' not found", nodeID)
+	}
+	if position < 1 || position > len(node.Subtasks) {
+		return fmt.Errorf("node '%s' has no subtask %d (it has %d)", nodeID, position, len(node.Subtasks))
+	}
+
+	index := position - 1
+	for _, dep := range node.Subtasks[index].DependsOn {
+		if dep >= 1 && dep <= len(node.Subtasks) && !node.Subtasks[dep-1].Completed {
+			return fmt.Errorf("subtask %d of node '%s' depends on incomplete subtask %d", position, nodeID, dep)
+		}
+	}
+
+	node.Subtasks[index].Completed = true
+	node.UpdateStatus()
+	node.RecalculateMaterialization(baseDir)
+	return nil
+}
+
+// CompleteNode marks every subtask of a node complete, along with the node,
+// and updates its materialization, looking up artifacts relative to baseDir
+func (m *MPC) CompleteNode(nodeID string, baseDir string) error {
+	node := m.GetNodeByID(nodeID)
+	if node == nil {
+		return fmt.Errorf("node '%s' not found", nodeID)
+	}
+
+	for i := range node.Subtasks {
+		node.Subtasks[i].Completed = true
+	}
+	node.Status = StatusCompleted
+	node.RecalculateMaterialization(baseDir)
+	return nil
+}
+
+// UpdateStatus derives a node's status from its subtasks: a node with every
+// subtask complete is Completed, and a Ready node with some complete is In
+// Progress. Blocked nodes stay Blocked until all their subtasks are done.
+func (n *Node) UpdateStatus() {
+	completed := n.GetCompletedSubtaskCount()
+	switch {
+	case len(n.Subtasks) > 0 && completed == len(n.Subtasks):
+		n.Status = StatusCompleted
+	case completed > 0 && n.Status == StatusReady:
+		n.Status = StatusInProgress
+	}
+}
+
+// SaveProgress writes the status, materialization and subtask completion of
+// the given nodes back to an MPC file. YAML files are patched in place: only
+// the changed values are rewritten, so comments, blank lines, quoting and
+// all other fields are kept. JSON files are rewritten in full.
+func SaveProgress(m *MPC, filePath string, nodeIDs ...string) error {
+	if strings.HasSuffix(strings.ToLower(filePath), ".json") {
+		return SaveMPCToFile(m, filePath)
+	}
+
+	data, err := os.ReadFile(filePath)
+	if err != nil {
+		return fmt.Errorf("failed to read file: %w", err)
+	}
+
+	var doc yaml.Node
+	if err := yaml.Unmarshal(data, &doc); err != nil {
+		return fmt.Errorf("failed to parse YAML: %w", err)
+	}
+	if len(doc.Content) == 0 {
+		return fmt.Errorf("file is empty")
+	}
+
+	nodes := mappingValue(doc.Content[0], "nodes")
+	if nodes == nil || nodes.Kind != yaml.SequenceNode {
+		return fmt.Errorf("file has no nodes list")
+	}
+
+	var edits []scalarEdit
+	for _, id := range nodeIDs {
+		node := m.GetNodeByID(id)
+		if node == nil {
+			return fmt.Errorf("node '%s' not found", id)
+		}
+		nodeEdits, err := progressEdits(nodes, node)
+		if err != nil {
+			return err
+		}
+		edits = append(edits, nodeEdits...)
+	}
+
+	content, ok := patchScalars(data, edits)
+	if !ok {
+		// A value could not be located in the text; re-encode the document
+		// instead, which keeps comments and key order but not blank lines
+		var buf bytes.Buffer
+		enc := yaml.NewEncoder(&buf)
+		enc.SetIndent(yamlIndent(data))
+		if err := enc.Encode(&doc); err != nil {
+			return fmt.Errorf("failed to encode YAML: %w", err)
+		}
+		if err := enc.Close(); err != nil {
+			return fmt.Errorf("failed to encode YAML: %w", err)
+		}
+		content = buf.Bytes()
+	}
+
+	return os.WriteFile(filePath, content, 0644)
+}
+
+// scalarEdit replaces the value of a scalar node in a YAML document.
+// A nil node means the value was not a scalar in the file and has only been
+// updated in the document tree.
+type scalarEdit struct {
+	node  *yaml.Node
+	value string
+}
+
+// progressEdits collects the edits that bring one node in a YAML nodes list
+// in line with its status, materialization and subtask completion, applying each edit to the
+// document tree as well
+func progressEdits(nodes *yaml.Node, node *Node) ([]scalarEdit, error) {
+	for _, item := range nodes.Content {
+		if id := mappingValue(item, "id"); id == nil || id.Value != node.ID {
+			continue
+		}
+
+		subtasks := mappingValue(item, "subtasks")
+		if subtasks == nil || len(subtasks.Content) != len(node.Subtasks) {
+			return nil, fmt.Errorf("subtasks of node '%s' do not match the file", node.ID)
+		}
+
+		edits := []scalarEdit{
+			setScalar(item, "status", node.Status, "!!str"),
+			setScalar(item, "materialization", strconv.FormatFloat(node.Materialization, 'f', -1, 64), "!!float"),
+		}
+		for i, subtask := range subtasks.Content {
+			edits = append(edits, setScalar(subtask, "completed", fmt.Sprintf("%t", node.Subtasks[i].Completed), "!!bool"))
+		}
+		return edits, nil
+	}
+	return nil, fmt.Errorf("node '%s' not found in file", node.ID)
+}
+
+// patchScalars rewrites scalar values in the raw YAML text, keeping each
+// value's quoting style. It reports false when an edit cannot be applied
+// textually, such as a missing key or a multi-line value. Edits sharing a
+// line, as in flow-style mappings, are applied right to left so that the
+// columns of the remaining edits stay valid.
+func patchScalars(data []byte, edits []scalarEdit) ([]byte, bool) {
+	lines := strings.Split(string(data), "\n")
+
+	for _, edit := range edits {
+		if edit.node == nil {
+			return nil, false
+		}
+	}
+	edits = append([]scalarEdit(nil), edits...)
+	sort.SliceStable(edits, func(i, j int) bool {
+		a, b := edits[i].node, edits[j].node
+		if a.Line != b.Line {
+			return a.Line < b.Line
+		}
+		return a.Column > b.Column
+	})
+
+	for _, edit := range edits {
+		n := edit.node
+		if n.Line < 1 || n.Line > len(lines) || n.Style&(yaml.LiteralStyle|yaml.FoldedStyle) != 0 {
+			return nil, false
+		}
+
+		line := lines[n.Line-1]
+		start := n.Column - 1
+		if start < 0 || start > len(line) {
+			return nil, false
+		}
+		length, ok := scalarTokenLength(line[start:], n.Style)
+		if !ok {
+			return nil, false
+		}
+
+		value := edit.value
+		switch {
+		case n.Style&yaml.DoubleQuotedStyle != 0:
+			value = `"` + value + `"`
+		case n.Style&yaml.SingleQuotedStyle != 0:
+			value = "'" + value + "'"
+		}
+		lines[n.Line-1] = line[:start] + value + line[start+length:]
+	}
+
+	return []byte(strings.Join(lines, "\n")), true
+}
+
+// scalarTokenLength returns the length of the scalar token at the start of s
+func scalarTokenLength(s string, style yaml.Style) (int, bool) {
+	switch {
+	case style&yaml.DoubleQuotedStyle != 0:
+		for i := 1; i < len(s); i++ {
+			if s[i] == '\\' {
+				i++
+			} else if s[i] == '"' {
+				return i + 1, true
+			}
+		}
+		return 0, false
+	case style&yaml.SingleQuotedStyle != 0:
+		for i := 1; i < len(s); i++ {
+			if s[i] == '\'' {
+				if i+1 < len(s) && s[i+1] == '\'' {
+					i++
+					continue
+				}
+				return i + 1, true
+			}
+		}
+		return 0, false
+	default:
+		end := len(s)
+		if i := strings.Index(s, " #"); i >= 0 {
+			end = i
+		}
+		if i := strings.IndexAny(s[:end], ",]}"); i >= 0 {
+			end = i
+		}
+		return len(strings.TrimRight(s[:end], " \t\r")), true
+	}
+}
+
+// mappingValue returns the value node for a key in a YAML mapping
+func mappingValue(mapping *yaml.Node, key string) *yaml.Node {
+	if mapping.Kind != yaml.MappingNode {
+		return nil
+	}
+	for i := 0; i+1 < len(mapping.Content); i += 2 {
+		if mapping.Content[i].Value == key {
+			return mapping.Content[i+1]
+		}
+	}
+	return nil
+}
+
+// setScalar sets a scalar value in a YAML mapping, appending the key when it
+// is missing. Existing quoting style is kept.
+func setScalar(mapping *yaml.Node, key, value, tag string) scalarEdit {
+	if existing := mappingValue(mapping, key); existing != nil {
+		if existing.Kind == yaml.ScalarNode {
+			existing.Tag = tag
+			existing.Value = value
+			return scalarEdit{node: existing, value: value}
+		}
+		*existing = yaml.Node{Kind: yaml.ScalarNode, Tag: tag, Value: value}
+		return scalarEdit{value: value}
+	}
+	mapping.Content = append(mapping.Content,
+		&yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: key},
+		&yaml.Node{Kind: yaml.ScalarNode, Tag: tag, Value: value},
+	)
+	return scalarEdit{value: value}
+}
+
+// yamlIndent guesses the indentation width of a YAML document from its first
+// indented line, defaulting to two spaces
+func yamlIndent(data []byte) int {
+	for _, line := range strings.Split(string(data), "\n") {
+		trimmed := strings.TrimLeft(line, " ")
+		if trimmed == "" || strings.HasPrefix(trimmed, "#") || len(trimmed) == len(line) {
+			continue
+		}
+		if indent := len(line) - len(trimmed); indent >= 2 {
+			return indent
+		}
+	}
+	return 2
+}
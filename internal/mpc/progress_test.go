@@ -0,0 +1,212 @@
+package mpc
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+const progressYAML = `version: "0.4"
+plan_id: 1754400000-progress
+plan_name: progress
+
+# The entry node starts the plan
+entry_node: setup
+nodes:
+  - id: setup
+    status: "Ready" # updated by mpc complete
+    materialization: 0.2
+    description: Setup
+    detailed_description: Setup work
+    subtasks:
+      - description: "first"
+        completed: false
+
+      - description: "second"
+        completed: false
+        depends_on: [1]
+    acceptance_criteria: [works]
+    definition_of_done: done
+    downstream: [build]
+  - id: build
+    status: 'Blocked'
+    materialization: 0
+    description: Build
+    detailed_description: Build work
+    subtasks:
+      - {description: only, completed: false}
+    acceptance_criteria: [works]
+    definition_of_done: done
+    downstream: []
+`
+
+func writeProgressPlan(t *testing.T) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "plan.yaml")
+	if err := os.WriteFile(path, []byte(progressYAML), 0644); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestCompleteSubtaskStatusTransitions(t *testing.T) {
+	m, err := LoadMPCFromFile(writeProgressPlan(t))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := m.CompleteSubtask("setup", 2, ""); err == nil {
+		t.Error("Expected an error completing a subtask with incomplete prerequisites")
+	}
+
+	if err := m.CompleteSubtask("setup", 1, ""); err != nil {
+		t.Fatalf("CompleteSubtask() error = %v", err)
+	}
+	if got := m.GetNodeByID("setup").Status; got != StatusInProgress {
+		t.Errorf("Status after first subtask = %s, want %s", got, StatusInProgress)
+	}
+
+	if err := m.CompleteSubtask("setup", 2, ""); err != nil {
+		t.Fatalf("CompleteSubtask() error = %v", err)
+	}
+	if got := m.GetNodeByID("setup").Status; got != StatusCompleted {
+		t.Errorf("Status after all subtasks = %s, want %s", got, StatusCompleted)
+	}
+
+	for _, tc := range []struct {
+		node     string
+		position int
+	}{{"missing", 1}, {"setup", 0}, {"setup", 3}} {
+		if err := m.CompleteSubtask(tc.node, tc.position, ""); err == nil {
+			t.Errorf("CompleteSubtask(%s, %d) should fail", tc.node, tc.position)
+		}
+	}
+	if err := m.CompleteNode("missing", ""); err == nil {
+		t.Error("CompleteNode() should fail for an unknown node")
+	}
+}
+
+func TestSaveProgressPreservesFormatting(t *testing.T) {
+	path := writeProgressPlan(t)
+	m, err := LoadMPCFromFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := m.CompleteSubtask("setup", 1, ""); err != nil {
+		t.Fatal(err)
+	}
+	if err := m.CompleteNode("build", ""); err != nil {
+		t.Fatal(err)
+	}
+	if err := SaveProgress(m, path, "setup", "build"); err != nil {
+		t.Fatalf("SaveProgress() error = %v", err)
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := progressYAML
+	for _, r := range [][2]string{
+		{`status: "Ready" # updated`, `status: "In Progress" # updated`},
+		{"materialization: 0.2", "materialization: 0.5"},
+		{"materialization: 0\n", "materialization: 1\n"},
+		{"\"first\"\n        completed: false", "\"first\"\n        completed: true"},
+		{`status: 'Blocked'`, `status: 'Completed'`},
+		{`{description: only, completed: false}`, `{description: only, completed: true}`},
+	} {
+		want = strings.Replace(want, r[0], r[1], 1)
+	}
+	if string(data) != want {
+		t.Errorf("Saved file:\n%s\nwant:\n%s", data, want)
+	}
+
+	reloaded, err := LoadMPCFromFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := reloaded.GetNodeByID("setup"); got.Status != StatusInProgress || got.Materialization != 0.5 ||
+		!got.Subtasks[0].Completed || got.Subtasks[1].Completed {
+		t.Errorf("Reloaded setup node = %+v", got)
+	}
+	if got := reloaded.GetNodeByID("build"); got.Status != StatusCompleted || got.Materialization != 1.0 || !got.Subtasks[0].Completed {
+		t.Errorf("Reloaded build node = %+v", got)
+	}
+}
+
+func TestSaveProgressJSON(t *testing.T) {
+	m, err := LoadMPCFromFile(writeProgressPlan(t))
+	if err != nil {
+		t.Fatal(err)
+	}
+	path := filepath.Join(t.TempDir(), "plan.json")
+	if err := SaveMPCToFile(m, path); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := m.CompleteNode("setup", ""); err != nil {
+		t.Fatal(err)
+	}
+	if err := SaveProgress(m, path, "setup"); err != nil {
+		t.Fatalf("SaveProgress() error = %v", err)
+	}
+
+	reloaded, err := LoadMPCFromFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := reloaded.GetNodeByID("setup"); got.Status != StatusCompleted || got.GetCompletedSubtaskCount() != 2 {
+		t.Errorf("Reloaded setup node = %+v", got)
+	}
+}
+
+func TestSaveProgressFlowStyleNode(t *testing.T) {
+	// Every value SaveProgress edits sits on the same line
+	plan := `version: "0.4"
+plan_id: 1754400000-flow
+plan_name: flow
+entry_node: solo
+nodes:
+  - {id: solo, status: Ready, materialization: 0, description: Solo, detailed_description: Solo work, subtasks: [{description: a, completed: false}, {description: b, completed: false}], acceptance_criteria: [works], definition_of_done: done, downstream: []}
+`
+	path := filepath.Join(t.TempDir(), "plan.yaml")
+	if err := os.WriteFile(path, []byte(plan), 0644); err != nil {
+		t.Fatal(err)
+	}
+	m, err := LoadMPCFromFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := m.CompleteNode("solo", ""); err != nil {
+		t.Fatal(err)
+	}
+	if err := SaveProgress(m, path, "solo"); err != nil {
+		t.Fatalf("SaveProgress() error = %v", err)
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := strings.NewReplacer(
+		"status: Ready", "status: Completed",
+		"materialization: 0,", "materialization: 1,",
+		"completed: false", "completed: true",
+	).Replace(plan)
+	if string(data) != want {
+		t.Errorf("Saved file:\n%s\nwant:\n%s", data, want)
+	}
+
+	reloaded, err := LoadMPCFromFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := reloaded.GetNodeByID("solo"); got.Status != StatusCompleted || got.Materialization != 1.0 ||
+		!got.Subtasks[0].Completed || !got.Subtasks[1].Completed {
+		t.Errorf("Reloaded solo node = %+v", got)
+	}
+}
@@ -27,16 +27,80 @@ func LoadMPCFromFile(filePath string) (*MPC, error) {
 
 	// Try YAML first
 	var mpc MPC
-	if err := yaml.Unmarshal(data, &mpc); err == nil {
+	yamlErr := yaml.Unmarshal(data, &mpc)
+	if yamlErr == nil {
+		mpc.normalize()
 		return &mpc, nil
 	}
 
 	// Try JSON
+	mpc = MPC{}
 	if err := json.Unmarshal(data, &mpc); err == nil {
+		mpc.normalize()
 		return &mpc, nil
 	}
 
-	return nil, fmt.Errorf("failed to parse file as YAML or JSON")
+	return nil, fmt.Errorf("failed to parse file as YAML or JSON: %w", yamlErr)
+}
+
+// SaveMPCToFile writes an MPC plan to disk, as JSON when the path ends in
+// .json and as YAML otherwise. Loading the written file yields the same plan.
+func SaveMPCToFile(m *MPC, filePath string) error {
+	format := "yaml"
+	if strings.HasSuffix(strings.ToLower(filePath), ".json") {
+		format = "json"
+	}
+
+	content, err := NewRenderer(m).Render(format)
+	if err != nil {
+		return err
+	}
+	if !strings.HasSuffix(content, "\n") {
+		content += "\n"
+	}
+
+	return os.WriteFile(filePath, []byte(content), 0644)
+}
+
+// normalize makes nil and empty slices consistent with how they are
+// written back: required lists are empty rather than nil, and lists that are
+// omitted when empty are nil
+func (m *MPC) normalize() {
+	emptyIfNil := func(s *[]string) {
+		if *s == nil {
+			*s = []string{}
+		}
+	}
+	nilIfEmpty := func(s *[]string) {
+		if len(*s) == 0 {
+			*s = nil
+		}
+	}
+
+	emptyIfNil(&m.Context.NonFunctionalRequirements)
+	emptyIfNil(&m.Architecture.ADRs)
+	emptyIfNil(&m.Architecture.Constraints)
+	emptyIfNil(&m.Tooling.SecondaryLanguages)
+	emptyIfNil(&m.Tooling.Frameworks)
+	if m.Nodes == nil {
+		m.Nodes = []Node{}
+	}
+
+	for i := range m.Nodes {
+		node := &m.Nodes[i]
+		emptyIfNil(&node.AcceptanceCriteria)
+		emptyIfNil(&node.Downstream)
+		nilIfEmpty(&node.Outputs)
+		nilIfEmpty(&node.RequiredKnowledge)
+		if node.Subtasks == nil {
+			node.Subtasks = []Subtask{}
+		}
+		for j := range node.Subtasks {
+			if len(node.Subtasks[j].DependsOn) == 0 {
+				node.Subtasks[j].DependsOn = nil
+			}
+		}
+	}
 }
 
 func (r *Renderer) Render(format string) (string, error) {
@@ -47,6 +111,8 @@ func (r *Renderer) Render(format string) (string, error) {
 		return r.renderJSON()
 	case "text":
 		return r.renderText()
+	case "dot":
+		return r.renderDOT()
 	default:
 		return "", fmt.Errorf("unsupported format: %s", format)
 	}
@@ -158,7 +224,7 @@ func (r *Renderer) renderText() (string, error) {
 			if subtask.Completed {
 				status = "[✓]"
 			}
-			sb.WriteString(fmt.Sprintf("  %d. %s %s\n", j+1, status, subtask.Description))
+			sb.WriteString(fmt.Sprintf("  %d. %s %s%s\n", j+1, status, subtask.Description, formatSubtaskDeps(subtask.DependsOn)))
 		}
 
 		// Outputs
@@ -303,4 +369,17 @@ func (r *Renderer) WriteToFile(filePath, format string) error {
 	}
 
 	return os.WriteFile(filePath, []byte(content), 0644)
-}
\ No newline at end of file
+}
+
+// formatSubtaskDeps renders subtask prerequisites using the same 1-based
+// numbering as the subtask list
+func formatSubtaskDeps(deps []int) string {
+	if len(deps) == 0 {
+		return ""
+	}
+	nums := make([]string, len(deps))
+	for i, dep := range deps {
+		nums[i] = fmt.Sprintf("%d", dep)
+	}
+	return fmt.Sprintf(" (after %s)", strings.Join(nums, ", "))
+}
@@ -0,0 +1,161 @@
+package mpc
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+const roundTripYAML = `version: "0.5"
+plan_id: 1754400000-round-trip
+plan_name: round-trip
+context:
+  business_goal: Exercise artifact encoding
+architecture:
+  overview: Test plan
+  adrs: []
+tooling:
+  primary_language: go
+  coding_standards:
+    lint: golangci-lint
+    formatting: gofmt
+    testing: go test
+entry_node: enriched
+nodes:
+  - id: enriched
+    status: In Progress
+    materialization: 0.5
+    description: Structured artifacts
+    detailed_description: Uses the enriched artifact format
+    subtasks:
+      - description: first
+        completed: true
+      - description: second
+        completed: false
+        depends_on: [1]
+    outputs: []
+    acceptance_criteria: [works]
+    definition_of_done: done
+    artifacts:
+      bpmn: ai/round-trip/bpmn/flow.json
+      properties:
+        invariants: ai/round-trip/properties/invariants.json
+      specs:
+        api: ai/round-trip/specs/api.yaml
+      tests:
+        unit: ai/round-trip/tests/unit/*
+    downstream: [legacy, bare]
+  - id: legacy
+    status: Blocked
+    materialization: 0
+    description: Old artifact format
+    detailed_description: Uses plain artifact paths
+    subtasks:
+      - description: only
+        completed: false
+        depends_on: []
+    acceptance_criteria: [works]
+    definition_of_done: done
+    artifacts:
+      spec: specs/api.yaml
+      tests: tests/*
+      properties: props.json
+  - id: bare
+    status: Blocked
+    materialization: 0
+    description: No artifacts
+    detailed_description: Has no artifacts declared
+    subtasks:
+      - description: only
+        completed: false
+    acceptance_criteria: [works]
+    definition_of_done: done
+`
+
+func TestMPCFileRoundTrip(t *testing.T) {
+	dir := t.TempDir()
+	source := filepath.Join(dir, "plan.yaml")
+	if err := os.WriteFile(source, []byte(roundTripYAML), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	original, err := LoadMPCFromFile(source)
+	if err != nil {
+		t.Fatalf("LoadMPCFromFile() error = %v", err)
+	}
+
+	enriched := original.GetNodeByID("enriched").Artifacts
+	if enriched.PropertiesStruct == nil || enriched.PropertiesStruct.Invariants != "ai/round-trip/properties/invariants.json" {
+		t.Errorf("Structured properties not loaded: %+v", enriched)
+	}
+	if enriched.TestsStruct == nil || enriched.TestsStruct.Unit != "ai/round-trip/tests/unit/*" {
+		t.Errorf("Structured tests not loaded: %+v", enriched)
+	}
+	legacy := original.GetNodeByID("legacy").Artifacts
+	if legacy.Tests != "tests/*" || legacy.Properties != "props.json" || legacy.TestsStruct != nil {
+		t.Errorf("Legacy artifacts not loaded: %+v", legacy)
+	}
+	if original.GetNodeByID("bare").Artifacts != nil {
+		t.Error("Node without artifacts should have nil Artifacts")
+	}
+
+	for _, name := range []string{"saved.yaml", "saved.json"} {
+		t.Run(name, func(t *testing.T) {
+			path := filepath.Join(dir, name)
+			if err := SaveMPCToFile(original, path); err != nil {
+				t.Fatalf("SaveMPCToFile() error = %v", err)
+			}
+			reloaded, err := LoadMPCFromFile(path)
+			if err != nil {
+				t.Fatalf("LoadMPCFromFile() error = %v", err)
+			}
+			if !reflect.DeepEqual(original, reloaded) {
+				t.Errorf("Round trip through %s changed the plan:\noriginal: %+v\nreloaded: %+v", name, original, reloaded)
+			}
+		})
+	}
+}
+
+func TestArtifactsBothFormatsRoundTrip(t *testing.T) {
+	// A node carrying the string and the mapping form of the same key keeps both
+	m := &MPC{
+		Nodes: []Node{{
+			ID: "both",
+			Artifacts: &Artifacts{
+				Tests:       "tests/*",
+				TestsStruct: &ArtifactTests{Unit: "unit/*"},
+			},
+		}},
+	}
+	m.normalize()
+
+	for _, name := range []string{"both.yaml", "both.json"} {
+		path := filepath.Join(t.TempDir(), name)
+		if err := SaveMPCToFile(m, path); err != nil {
+			t.Fatal(err)
+		}
+		reloaded, err := LoadMPCFromFile(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(m, reloaded) {
+			t.Errorf("%s: got %+v, want %+v", name, reloaded.Nodes[0].Artifacts, m.Nodes[0].Artifacts)
+		}
+	}
+}
+
+func TestLoadEnrichedExample(t *testing.T) {
+	m, err := LoadMPCFromFile("../../examples/enriched-artifacts-example.yaml")
+	if err != nil {
+		t.Fatalf("LoadMPCFromFile() error = %v", err)
+	}
+	for _, node := range m.Nodes {
+		if node.Artifacts == nil {
+			continue
+		}
+		if node.Artifacts.Tests != "" || node.Artifacts.Properties != "" {
+			t.Errorf("Node %s: structured artifacts decoded as strings: %+v", node.ID, node.Artifacts)
+		}
+	}
+}
@@ -0,0 +1,106 @@
+package mpc
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestNextActionableSubtasksChain(t *testing.T) {
+	// 1 -> 2 -> 3
+	node := &Node{Subtasks: []Subtask{
+		{Description: "design"},
+		{Description: "implement", DependsOn: []int{1}},
+		{Description: "test", DependsOn: []int{2}},
+	}}
+
+	steps := [][]int{{1}, {2}, {3}, {}}
+	for step, want := range steps {
+		got := node.NextActionableSubtasks()
+		if !reflect.DeepEqual(got, want) {
+			t.Fatalf("step %d: NextActionableSubtasks() = %v, want %v", step, got, want)
+		}
+		if len(got) > 0 {
+			node.Subtasks[got[0]-1].Completed = true
+		}
+	}
+}
+
+func TestNextActionableSubtasksParallel(t *testing.T) {
+	// Subtasks 1 and 2 are independent; 3 waits for both; 4 has no dependencies
+	node := &Node{Subtasks: []Subtask{
+		{Description: "write API"},
+		{Description: "write client"},
+		{Description: "integrate", DependsOn: []int{1, 2}},
+		{Description: "docs"},
+	}}
+
+	if got := node.NextActionableSubtasks(); !reflect.DeepEqual(got, []int{1, 2, 4}) {
+		t.Errorf("NextActionableSubtasks() = %v, want [1 2 4]", got)
+	}
+
+	node.Subtasks[0].Completed = true
+	if got := node.NextActionableSubtasks(); !reflect.DeepEqual(got, []int{2, 4}) {
+		t.Errorf("After completing 1: NextActionableSubtasks() = %v, want [2 4]", got)
+	}
+
+	node.Subtasks[1].Completed = true
+	if got := node.NextActionableSubtasks(); !reflect.DeepEqual(got, []int{3, 4}) {
+		t.Errorf("After completing 2: NextActionableSubtasks() = %v, want [3 4]", got)
+	}
+}
+
+func TestValidateSubtaskDependencies(t *testing.T) {
+	tests := []struct {
+		name     string
+		subtasks []Subtask
+		want     string
+	}{
+		{
+			name:     "valid",
+			subtasks: []Subtask{{}, {DependsOn: []int{1}}},
+		},
+		{
+			name:     "out of range",
+			subtasks: []Subtask{{}, {DependsOn: []int{5}}},
+			want:     "nodes[0].subtasks[1].depends_on[0]: subtask 2 depends on subtask 5, which is out of range",
+		},
+		{
+			name:     "zero is out of range",
+			subtasks: []Subtask{{}, {DependsOn: []int{0}}},
+			want:     "nodes[0].subtasks[1].depends_on[0]: subtask 2 depends on subtask 0, which is out of range",
+		},
+		{
+			name:     "self",
+			subtasks: []Subtask{{DependsOn: []int{1}}},
+			want:     "nodes[0].subtasks[0].depends_on[0]: subtask 1 cannot depend on itself",
+		},
+		{
+			name:     "cycle",
+			subtasks: []Subtask{{DependsOn: []int{3}}, {DependsOn: []int{1}}, {DependsOn: []int{2}}},
+			want:     "nodes[0].subtasks[0].depends_on: circular subtask dependency detected involving subtask 1",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			errs := validateSubtaskDependencies(Node{Subtasks: tt.subtasks}, "nodes[0]")
+			if tt.want == "" {
+				if len(errs) != 0 {
+					t.Errorf("Expected no errors, got %+v", errs)
+				}
+				return
+			}
+			if len(errs) != 1 || !strings.HasPrefix(errs[0].Path+": "+errs[0].Message, tt.want) {
+				t.Errorf("Errors = %+v, want %q", errs, tt.want)
+			}
+		})
+	}
+}
+
+func TestFormatSubtaskDepsUsesPositions(t *testing.T) {
+	// depends_on holds the same 1-based numbers the rendered plan shows
+	if got := formatSubtaskDeps([]int{1, 3}); got != " (after 1, 3)" {
+		t.Errorf("formatSubtaskDeps() = %q, want \" (after 1, 3)\"", got)
+	}
+}
@@ -53,6 +53,7 @@ type Node struct {
 type Subtask struct {
 	Description string `json:"description" yaml:"description"`
 	Completed   bool   `json:"completed" yaml:"completed"`
+	DependsOn   []int  `json:"depends_on,omitempty" yaml:"depends_on,omitempty"` // 1-based positions of prerequisite subtasks
 }
 
 // Artifacts supports both old (simple string) and new (structured) formats
@@ -126,6 +127,30 @@ func (n *Node) GetCompletedSubtaskCount() int {
 	return count
 }
 
+// NextActionableSubtasks returns the positions of incomplete subtasks whose
+// prerequisites are all complete. Positions are numbered from 1, like
+// DependsOn and 'mpc complete --subtask', and a prerequisite outside the
+// subtask list is never satisfied.
+func (n *Node) NextActionableSubtasks() []int {
+	actionable := []int{}
+	for i, subtask := range n.Subtasks {
+		if subtask.Completed {
+			continue
+		}
+		ready := true
+		for _, dep := range subtask.DependsOn {
+			if dep < 1 || dep > len(n.Subtasks) || !n.Subtasks[dep-1].Completed {
+				ready = false
+				break
+			}
+		}
+		if ready {
+			actionable = append(actionable, i+1)
+		}
+	}
+	return actionable
+}
+
 func (n *Node) GetCompletionPercentage() float64 {
 	if len(n.Subtasks) == 0 {
 		return 0
@@ -168,6 +168,9 @@ func (v *Validator) validateSemantics(mpc *MPC) ([]ValidationError, []Validation
 			}
 		}
 
+		// Validate subtask dependencies
+		errors = append(errors, validateSubtaskDependencies(node, nodePath)...)
+
 		// Check for circular dependencies
 		if hasCircularDependency(node.ID, nodeMap, make(map[string]bool)) {
 			errors = append(errors, ValidationError{
@@ -289,6 +292,62 @@ func (v *Validator) validateArtifacts(artifacts *Artifacts, nodePath string) err
 	return nil
 }
 
+// validateSubtaskDependencies checks that subtask dependencies refer to other
+// subtasks of the same node and do not form a cycle. Dependencies and the
+// subtask numbers in messages are 1-based positions.
+func validateSubtaskDependencies(node Node, nodePath string) []ValidationError {
+	errors := []ValidationError{}
+	valid := true
+
+	for i, subtask := range node.Subtasks {
+		for j, dep := range subtask.DependsOn {
+			path := fmt.Sprintf("%s.subtasks[%d].depends_on[%d]", nodePath, i, j)
+			if dep < 1 || dep > len(node.Subtasks) {
+				errors = append(errors, ValidationError{
+					Path:    path,
+					Message: fmt.Sprintf("subtask %d depends on subtask %d, which is out of range (node has %d subtasks, numbered from 1)", i+1, dep, len(node.Subtasks)),
+				})
+				valid = false
+			} else if dep == i+1 {
+				errors = append(errors, ValidationError{
+					Path:    path,
+					Message: fmt.Sprintf("subtask %d cannot depend on itself", i+1),
+				})
+				valid = false
+			}
+		}
+	}
+
+	if !valid {
+		return errors
+	}
+
+	// Depth-first search for cycles: 1 = on the current path, 2 = done
+	state := make([]int, len(node.Subtasks))
+	var visit func(i int) bool
+	visit = func(i int) bool {
+		state[i] = 1
+		for _, dep := range node.Subtasks[i].DependsOn {
+			if j := dep - 1; state[j] == 1 || (state[j] == 0 && visit(j)) {
+				return true
+			}
+		}
+		state[i] = 2
+		return false
+	}
+	for i := range node.Subtasks {
+		if state[i] == 0 && visit(i) {
+			errors = append(errors, ValidationError{
+				Path:    fmt.Sprintf("%s.subtasks[%d].depends_on", nodePath, i),
+				Message: fmt.Sprintf("circular subtask dependency detected involving subtask %d", i+1),
+			})
+			break
+		}
+	}
+
+	return errors
+}
+
 func isValidStatus(status string) bool {
 	validStatuses := []string{StatusReady, StatusInProgress, StatusBlocked, StatusCompleted}
 	for _, valid := range validStatuses {
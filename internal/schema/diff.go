@@ -0,0 +1,266 @@
+package schema
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Change kinds reported by DiffSchemas
+const (
+	ChangePropertyAdded   = "property-added"
+	ChangePropertyRemoved = "property-removed"
+	ChangeTypeChanged     = "type-changed"
+	ChangeRequiredAdded   = "required-added"
+	ChangeRequiredRemoved = "required-removed"
+)
+
+// SchemaChange describes a single difference between two schema versions.
+// Path is a dotted property path; "[]" marks array items and "(root)" the
+// top-level schema.
+type SchemaChange struct {
+	Path     string `json:"path"`
+	Kind     string `json:"kind"`
+	Old      string `json:"old,omitempty"`
+	New      string `json:"new,omitempty"`
+	Breaking bool   `json:"breaking"`
+}
+
+// SchemaDiff is the set of changes between two schema versions
+type SchemaDiff struct {
+	Changes []SchemaChange `json:"changes"`
+}
+
+// HasBreaking reports whether any change is breaking
+func (d *SchemaDiff) HasBreaking() bool {
+	for _, c := range d.Changes {
+		if c.Breaking {
+			return true
+		}
+	}
+	return false
+}
+
+// DiffSchemas compares two JSON schemas, walking properties, array items
+// and definitions. Removing a property, newly requiring one, or narrowing
+// a type is breaking; the reverse changes are compatible. References are
+// compared by name only and are not followed.
+func DiffSchemas(oldSchema, newSchema map[string]interface{}) *SchemaDiff {
+	diff := &SchemaDiff{Changes: []SchemaChange{}}
+	diffNode(diff, "", oldSchema, newSchema)
+
+	sort.SliceStable(diff.Changes, func(i, j int) bool {
+		return diff.Changes[i].Path < diff.Changes[j].Path
+	})
+	return diff
+}
+
+func diffNode(diff *SchemaDiff, path string, oldNode, newNode map[string]interface{}) {
+	oldTypes, newTypes := schemaTypes(oldNode), schemaTypes(newNode)
+	if strings.Join(oldTypes, "|") != strings.Join(newTypes, "|") {
+		diff.Changes = append(diff.Changes, SchemaChange{
+			Path:     displayPath(path),
+			Kind:     ChangeTypeChanged,
+			Old:      typeLabel(oldTypes),
+			New:      typeLabel(newTypes),
+			Breaking: !widens(oldTypes, newTypes),
+		})
+	}
+
+	oldRequired, newRequired := stringSet(oldNode["required"]), stringSet(newNode["required"])
+	oldProps, newProps := objectMap(oldNode["properties"]), objectMap(newNode["properties"])
+
+	for _, name := range unionKeys(oldProps, newProps) {
+		propPath := joinPath(path, name)
+		oldProp, inOld := oldProps[name]
+		newProp, inNew := newProps[name]
+
+		switch {
+		case inOld && !inNew:
+			diff.Changes = append(diff.Changes, SchemaChange{
+				Path:     propPath,
+				Kind:     ChangePropertyRemoved,
+				Old:      typeLabel(schemaTypes(oldProp)),
+				Breaking: true,
+			})
+		case !inOld && inNew:
+			diff.Changes = append(diff.Changes, SchemaChange{
+				Path:     propPath,
+				Kind:     ChangePropertyAdded,
+				New:      typeLabel(schemaTypes(newProp)),
+				Breaking: newRequired[name],
+			})
+		default:
+			diffNode(diff, propPath, oldProp, newProp)
+		}
+
+		// Required changes only matter for properties present in both versions;
+		// removals and additions already account for their required state
+		if !inOld || !inNew {
+			continue
+		}
+		if !oldRequired[name] && newRequired[name] {
+			diff.Changes = append(diff.Changes, SchemaChange{Path: propPath, Kind: ChangeRequiredAdded, Breaking: true})
+		} else if oldRequired[name] && !newRequired[name] {
+			diff.Changes = append(diff.Changes, SchemaChange{Path: propPath, Kind: ChangeRequiredRemoved})
+		}
+	}
+
+	if oldItems, ok := oldNode["items"].(map[string]interface{}); ok {
+		if newItems, ok := newNode["items"].(map[string]interface{}); ok {
+			diffNode(diff, path+"[]", oldItems, newItems)
+		}
+	}
+
+	for _, key := range []string{"definitions", "$defs"} {
+		oldDefs, newDefs := objectMap(oldNode[key]), objectMap(newNode[key])
+		for _, name := range unionKeys(oldDefs, newDefs) {
+			defPath := joinPath(path, key+"."+name)
+			oldDef, inOld := oldDefs[name]
+			newDef, inNew := newDefs[name]
+			switch {
+			case inOld && !inNew:
+				diff.Changes = append(diff.Changes, SchemaChange{Path: defPath, Kind: ChangePropertyRemoved, Old: typeLabel(schemaTypes(oldDef)), Breaking: true})
+			case !inOld && inNew:
+				diff.Changes = append(diff.Changes, SchemaChange{Path: defPath, Kind: ChangePropertyAdded, New: typeLabel(schemaTypes(newDef))})
+			default:
+				diffNode(diff, defPath, oldDef, newDef)
+			}
+		}
+	}
+}
+
+// schemaTypes returns the sorted types a schema node accepts. A $ref with
+// no explicit type is reported by its reference target.
+func schemaTypes(node map[string]interface{}) []string {
+	var types []string
+	switch t := node["type"].(type) {
+	case string:
+		types = []string{t}
+	case []interface{}:
+		for _, v := range t {
+			if s, ok := v.(string); ok {
+				types = append(types, s)
+			}
+		}
+	}
+	if len(types) == 0 {
+		if ref, ok := node["$ref"].(string); ok {
+			types = []string{ref}
+		}
+	}
+	sort.Strings(types)
+	return types
+}
+
+// widens reports whether every value accepted by the old types is still
+// accepted by the new types. An empty type list accepts anything, and
+// "number" accepts integers.
+func widens(oldTypes, newTypes []string) bool {
+	if len(newTypes) == 0 {
+		return true
+	}
+	if len(oldTypes) == 0 {
+		return false
+	}
+	accepted := make(map[string]bool, len(newTypes))
+	for _, t := range newTypes {
+		accepted[t] = true
+	}
+	for _, t := range oldTypes {
+		if !accepted[t] && !(t == "integer" && accepted["number"]) {
+			return false
+		}
+	}
+	return true
+}
+
+func typeLabel(types []string) string {
+	if len(types) == 0 {
+		return "any"
+	}
+	return strings.Join(types, "|")
+}
+
+func objectMap(v interface{}) map[string]map[string]interface{} {
+	result := make(map[string]map[string]interface{})
+	m, ok := v.(map[string]interface{})
+	if !ok {
+		return result
+	}
+	for key, value := range m {
+		if node, ok := value.(map[string]interface{}); ok {
+			result[key] = node
+		}
+	}
+	return result
+}
+
+func stringSet(v interface{}) map[string]bool {
+	set := make(map[string]bool)
+	list, _ := v.([]interface{})
+	for _, item := range list {
+		if s, ok := item.(string); ok {
+			set[s] = true
+		}
+	}
+	return set
+}
+
+func unionKeys(a, b map[string]map[string]interface{}) []string {
+	keys := make([]string, 0, len(a)+len(b))
+	for k := range a {
+		keys = append(keys, k)
+	}
+	for k := range b {
+		if _, ok := a[k]; !ok {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func joinPath(path, name string) string {
+	if path == "" {
+		return name
+	}
+	return path + "." + name
+}
+
+func displayPath(path string) string {
+	if path == "" {
+		return "(root)"
+	}
+	return path
+}
+
+// FormatSchemaDiff renders a schema diff as a human-readable report
+func FormatSchemaDiff(diff *SchemaDiff) string {
+	if len(diff.Changes) == 0 {
+		return "No changes\n"
+	}
+
+	var sb strings.Builder
+	breaking := 0
+	for _, c := range diff.Changes {
+		label := "compatible"
+		if c.Breaking {
+			label = "BREAKING"
+			breaking++
+		}
+		sb.WriteString(fmt.Sprintf("  [%s] %s %s", label, c.Kind, c.Path))
+		switch {
+		case c.Old != "" && c.New != "":
+			sb.WriteString(fmt.Sprintf(": %s -> %s", c.Old, c.New))
+		case c.Old != "":
+			sb.WriteString(fmt.Sprintf(" (%s)", c.Old))
+		case c.New != "":
+			sb.WriteString(fmt.Sprintf(" (%s)", c.New))
+		}
+		sb.WriteString("\n")
+	}
+	sb.WriteString(fmt.Sprintf("\n%d change(s), %d breaking\n", len(diff.Changes), breaking))
+
+	return sb.String()
+}
@@ -0,0 +1,117 @@
+package schema
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func mustSchema(t *testing.T, s string) map[string]interface{} {
+	t.Helper()
+	var content map[string]interface{}
+	if err := json.Unmarshal([]byte(s), &content); err != nil {
+		t.Fatal(err)
+	}
+	return content
+}
+
+const userSchemaV1 = `{
+	"type": "object",
+	"required": ["id", "email"],
+	"properties": {
+		"id": {"type": "string"},
+		"email": {"type": "string"},
+		"age": {"type": "integer"},
+		"tags": {"type": "array", "items": {"type": "object", "properties": {"name": {"type": "string"}}}}
+	}
+}`
+
+func TestDiffSchemasRemovedRequiredField(t *testing.T) {
+	newSchema := mustSchema(t, `{
+		"type": "object",
+		"required": ["id"],
+		"properties": {
+			"id": {"type": "string"},
+			"age": {"type": "integer"},
+			"tags": {"type": "array", "items": {"type": "object", "properties": {"name": {"type": "string"}}}}
+		}
+	}`)
+
+	diff := DiffSchemas(mustSchema(t, userSchemaV1), newSchema)
+	if len(diff.Changes) != 1 {
+		t.Fatalf("Expected 1 change, got %+v", diff.Changes)
+	}
+	want := SchemaChange{Path: "email", Kind: ChangePropertyRemoved, Old: "string", Breaking: true}
+	if diff.Changes[0] != want {
+		t.Errorf("Change = %+v, want %+v", diff.Changes[0], want)
+	}
+	if !diff.HasBreaking() {
+		t.Error("Removing a required field should be breaking")
+	}
+}
+
+func TestDiffSchemasAddedOptionalField(t *testing.T) {
+	newSchema := mustSchema(t, `{
+		"type": "object",
+		"required": ["id", "email"],
+		"properties": {
+			"id": {"type": "string"},
+			"email": {"type": "string"},
+			"age": {"type": "integer"},
+			"nickname": {"type": "string"},
+			"tags": {"type": "array", "items": {"type": "object", "properties": {"name": {"type": "string"}}}}
+		}
+	}`)
+
+	diff := DiffSchemas(mustSchema(t, userSchemaV1), newSchema)
+	if len(diff.Changes) != 1 {
+		t.Fatalf("Expected 1 change, got %+v", diff.Changes)
+	}
+	want := SchemaChange{Path: "nickname", Kind: ChangePropertyAdded, New: "string"}
+	if diff.Changes[0] != want {
+		t.Errorf("Change = %+v, want %+v", diff.Changes[0], want)
+	}
+	if diff.HasBreaking() {
+		t.Error("Adding an optional field should be compatible")
+	}
+}
+
+func TestDiffSchemasTypesAndRequired(t *testing.T) {
+	newSchema := mustSchema(t, `{
+		"type": "object",
+		"required": ["email", "age"],
+		"properties": {
+			"id": {"type": ["string", "integer"]},
+			"email": {"type": "string"},
+			"age": {"type": "number"},
+			"tags": {"type": "array", "items": {"type": "object", "properties": {"name": {"type": "integer"}}}}
+		}
+	}`)
+
+	diff := DiffSchemas(mustSchema(t, userSchemaV1), newSchema)
+
+	var got []string
+	for _, c := range diff.Changes {
+		got = append(got, c.Path+" "+c.Kind+" "+map[bool]string{true: "breaking", false: "compatible"}[c.Breaking])
+	}
+	want := []string{
+		"age type-changed compatible",
+		"age required-added breaking",
+		"id type-changed compatible",
+		"id required-removed compatible",
+		"tags[].name type-changed breaking",
+	}
+	if strings.Join(got, "\n") != strings.Join(want, "\n") {
+		t.Errorf("Changes:\n%s\nwant:\n%s", strings.Join(got, "\n"), strings.Join(want, "\n"))
+	}
+}
+
+func TestDiffSchemasIdentical(t *testing.T) {
+	diff := DiffSchemas(mustSchema(t, userSchemaV1), mustSchema(t, userSchemaV1))
+	if len(diff.Changes) != 0 {
+		t.Errorf("Expected no changes, got %+v", diff.Changes)
+	}
+	if got := FormatSchemaDiff(diff); got != "No changes\n" {
+		t.Errorf("FormatSchemaDiff() = %q", got)
+	}
+}
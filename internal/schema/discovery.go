@@ -54,6 +54,12 @@ func (r *Registry) Discover() error {
 }
 
 func (r *Registry) loadSchema(path string) (*Schema, error) {
+	return LoadFile(path)
+}
+
+// LoadFile reads a JSON schema from disk. The schema name is the file name
+// without its .json extension.
+func LoadFile(path string) (*Schema, error) {
 	file, err := os.Open(path)
 	if err != nil {
 		return nil, err
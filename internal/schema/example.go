@@ -0,0 +1,405 @@
+package schema
+
+import (
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"regexp"
+	"regexp/syntax"
+	"strings"
+	"unicode/utf8"
+)
+
+// GenerateExample builds a minimal example document for a schema. Only
+// required object properties are filled in, arrays get a single element,
+// and a value's default, const or first enum value is preferred over a
+// placeholder. Strings are built to match their pattern where the pattern
+// can be parsed. References are followed, including references to sibling
+// schema files, which are resolved from the directory of schema.Path.
+//
+// The example is validated against the schema, and an error listing the
+// validation failures is returned when it does not match.
+func GenerateExample(schema Schema) (json.RawMessage, error) {
+	g := &exampleGenerator{
+		root:      schema.Content,
+		path:      schema.Path,
+		documents: make(map[string]map[string]interface{}),
+		resolving: make(map[string]bool),
+	}
+
+	value, err := g.generate(schema.Content)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := json.MarshalIndent(value, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode example: %w", err)
+	}
+
+	result, err := validateExample(schema, data)
+	if err != nil {
+		return nil, err
+	}
+	if !result.Valid {
+		return nil, fmt.Errorf("generated example does not validate: %s", strings.Join(result.Errors, "; "))
+	}
+	return data, nil
+}
+
+// validateExample checks an example against its schema. Schemas loaded from
+// disk are validated by path so that sibling-file references resolve.
+func validateExample(schema Schema, data []byte) (*ValidationResult, error) {
+	if schema.Path == "" {
+		return ValidateObject(&schema, json.RawMessage(data))
+	}
+	return ValidateDocument(schema.Path, data)
+}
+
+type exampleGenerator struct {
+	root      map[string]interface{}
+	path      string // file the current root was loaded from, if any
+	documents map[string]map[string]interface{}
+	resolving map[string]bool
+}
+
+func (g *exampleGenerator) generate(node map[string]interface{}) (interface{}, error) {
+	if ref, ok := node["$ref"].(string); ok {
+		return g.generateRef(ref)
+	}
+
+	if value, ok := node["default"]; ok {
+		return value, nil
+	}
+	if value, ok := node["const"]; ok {
+		return value, nil
+	}
+	if values, ok := node["enum"].([]interface{}); ok && len(values) > 0 {
+		return values[0], nil
+	}
+
+	for _, key := range []string{"oneOf", "anyOf", "allOf"} {
+		if options, ok := node[key].([]interface{}); ok && len(options) > 0 {
+			if key == "allOf" {
+				return g.generate(mergeAllOf(node, options))
+			}
+			if first, ok := options[0].(map[string]interface{}); ok {
+				// An object schema keeps its own properties alongside the chosen option
+				if _, ok := node["properties"]; ok {
+					return g.generate(mergeAllOf(node, options[:1]))
+				}
+				return g.generate(first)
+			}
+		}
+	}
+
+	switch exampleType(node) {
+	case "object":
+		return g.generateObject(node)
+	case "array":
+		items, ok := node["items"].(map[string]interface{})
+		if !ok {
+			return []interface{}{}, nil
+		}
+		item, err := g.generate(items)
+		if err != nil {
+			return nil, err
+		}
+		return []interface{}{item}, nil
+	case "string":
+		return exampleString(node), nil
+	case "integer", "number":
+		if min, ok := node["minimum"].(float64); ok {
+			return min, nil
+		}
+		if min, ok := node["exclusiveMinimum"].(float64); ok {
+			return min + 1, nil
+		}
+		return 0, nil
+	case "boolean":
+		return false, nil
+	default:
+		return nil, nil
+	}
+}
+
+func (g *exampleGenerator) generateObject(node map[string]interface{}) (interface{}, error) {
+	result := make(map[string]interface{})
+	properties, _ := node["properties"].(map[string]interface{})
+	required, _ := node["required"].([]interface{})
+
+	for _, r := range required {
+		name, ok := r.(string)
+		if !ok {
+			continue
+		}
+		prop, ok := properties[name].(map[string]interface{})
+		if !ok {
+			// Required without a declared shape; any value is acceptable
+			result[name] = ""
+			continue
+		}
+		value, err := g.generate(prop)
+		if err != nil {
+			return nil, fmt.Errorf("property %s: %w", name, err)
+		}
+		result[name] = value
+	}
+
+	return result, nil
+}
+
+func (g *exampleGenerator) generateRef(ref string) (interface{}, error) {
+	file, fragment, _ := strings.Cut(ref, "#")
+	if fragment != "" && !strings.HasPrefix(fragment, "/") {
+		return nil, fmt.Errorf("unsupported reference %s: only JSON pointer fragments are resolved", ref)
+	}
+
+	root, path := g.root, g.path
+	if file != "" {
+		if strings.Contains(file, "://") || g.path == "" {
+			return nil, fmt.Errorf("unsupported reference %s: only references to sibling schema files are resolved", ref)
+		}
+		path = filepath.Join(filepath.Dir(g.path), filepath.FromSlash(file))
+		doc, err := g.loadDocument(path)
+		if err != nil {
+			return nil, fmt.Errorf("unresolved reference %s: %w", ref, err)
+		}
+		root = doc
+	}
+
+	key := path + "#" + fragment
+	if g.resolving[key] {
+		return nil, fmt.Errorf("recursive reference %s has no finite example", ref)
+	}
+
+	var target interface{} = root
+	if fragment != "" {
+		for _, part := range strings.Split(strings.TrimPrefix(fragment, "/"), "/") {
+			m, ok := target.(map[string]interface{})
+			if !ok {
+				return nil, fmt.Errorf("unresolved reference %s", ref)
+			}
+			target = m[part]
+		}
+	}
+	node, ok := target.(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("unresolved reference %s", ref)
+	}
+
+	// References inside the target resolve against its own document
+	prevRoot, prevPath := g.root, g.path
+	g.resolving[key] = true
+	g.root, g.path = root, path
+	defer func() {
+		delete(g.resolving, key)
+		g.root, g.path = prevRoot, prevPath
+	}()
+	return g.generate(node)
+}
+
+// loadDocument reads a sibling schema file, caching it by path
+func (g *exampleGenerator) loadDocument(path string) (map[string]interface{}, error) {
+	if doc, ok := g.documents[path]; ok {
+		return doc, nil
+	}
+	schema, err := LoadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	g.documents[path] = schema.Content
+	return schema.Content, nil
+}
+
+// mergeAllOf combines the properties and required lists of a node and its
+// subschemas into a single object schema; later subschemas win on conflicts
+func mergeAllOf(node map[string]interface{}, options []interface{}) map[string]interface{} {
+	properties := make(map[string]interface{})
+	var required []interface{}
+
+	for _, source := range append([]interface{}{node}, options...) {
+		m, ok := source.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if props, ok := m["properties"].(map[string]interface{}); ok {
+			for k, v := range props {
+				properties[k] = v
+			}
+		}
+		if req, ok := m["required"].([]interface{}); ok {
+			required = append(required, req...)
+		}
+	}
+
+	return map[string]interface{}{
+		"type":       "object",
+		"properties": properties,
+		"required":   required,
+	}
+}
+
+// exampleType returns the type to generate for a node, taking the first
+// non-null type from a type list and inferring object or array from
+// properties and items when no type is declared
+func exampleType(node map[string]interface{}) string {
+	switch t := node["type"].(type) {
+	case string:
+		return t
+	case []interface{}:
+		for _, v := range t {
+			if s, ok := v.(string); ok && s != "null" {
+				return s
+			}
+		}
+	}
+	if _, ok := node["properties"]; ok {
+		return "object"
+	}
+	if _, ok := node["items"]; ok {
+		return "array"
+	}
+	return ""
+}
+
+func exampleString(node map[string]interface{}) string {
+	min, max := 0, -1
+	if v, ok := node["minLength"].(float64); ok {
+		min = int(v)
+	}
+	if v, ok := node["maxLength"].(float64); ok {
+		max = int(v)
+	}
+	if pattern, ok := node["pattern"].(string); ok {
+		if s, ok := patternExample(pattern, min, max); ok {
+			return s
+		}
+	}
+
+	switch node["format"] {
+	case "date-time":
+		return "2024-01-01T00:00:00Z"
+	case "date":
+		return "2024-01-01"
+	case "email":
+		return "user@example.com"
+	case "uri":
+		return "https://example.com"
+	case "uuid":
+		return "00000000-0000-0000-0000-000000000000"
+	}
+
+	s := "example"
+	if len(s) < min {
+		s += strings.Repeat("x", min-len(s))
+	}
+	if max >= 0 && len(s) > max {
+		s = s[:max]
+	}
+	return s
+}
+
+// patternExample builds a short string matching a regular expression within
+// the given length bounds (max < 0 means unbounded). Alternations take their
+// first branch and character classes prefer a lowercase letter or digit;
+// repeated parts are taken as few times as possible, growing until the
+// string is long enough. Patterns that Go cannot parse, such as those with
+// lookarounds, are reported as not handled.
+func patternExample(pattern string, min, max int) (string, bool) {
+	re, err := syntax.Parse(pattern, syntax.Perl)
+	if err != nil {
+		return "", false
+	}
+	matcher, err := regexp.Compile(pattern)
+	if err != nil {
+		return "", false
+	}
+
+	for reps := 0; reps <= min; reps++ {
+		var sb strings.Builder
+		if !buildPatternExample(&sb, re, reps) {
+			return "", false
+		}
+		s := sb.String()
+		length := utf8.RuneCountInString(s)
+		if max >= 0 && length > max {
+			break
+		}
+		if length >= min && matcher.MatchString(s) {
+			return s, true
+		}
+	}
+	return "", false
+}
+
+// buildPatternExample writes a string matching re, taking each unbounded
+// repetition reps times (at least its minimum)
+func buildPatternExample(sb *strings.Builder, re *syntax.Regexp, reps int) bool {
+	repeat := func(sub *syntax.Regexp, min, max int) bool {
+		n := reps
+		if n < min {
+			n = min
+		}
+		if max >= 0 && n > max {
+			n = max
+		}
+		for i := 0; i < n; i++ {
+			if !buildPatternExample(sb, sub, reps) {
+				return false
+			}
+		}
+		return true
+	}
+
+	switch re.Op {
+	case syntax.OpEmptyMatch, syntax.OpBeginLine, syntax.OpEndLine,
+		syntax.OpBeginText, syntax.OpEndText, syntax.OpQuest:
+		return true
+	case syntax.OpLiteral:
+		sb.WriteString(string(re.Rune))
+	case syntax.OpCharClass:
+		r, ok := classExample(re.Rune)
+		if !ok {
+			return false
+		}
+		sb.WriteRune(r)
+	case syntax.OpAnyChar, syntax.OpAnyCharNotNL:
+		sb.WriteRune('x')
+	case syntax.OpCapture:
+		return buildPatternExample(sb, re.Sub[0], reps)
+	case syntax.OpStar:
+		return repeat(re.Sub[0], 0, -1)
+	case syntax.OpPlus:
+		return repeat(re.Sub[0], 1, -1)
+	case syntax.OpRepeat:
+		return repeat(re.Sub[0], re.Min, re.Max)
+	case syntax.OpConcat:
+		for _, sub := range re.Sub {
+			if !buildPatternExample(sb, sub, reps) {
+				return false
+			}
+		}
+	case syntax.OpAlternate:
+		return buildPatternExample(sb, re.Sub[0], reps)
+	default:
+		// Word boundaries and the like depend on context
+		return false
+	}
+	return true
+}
+
+// classExample picks a rune from a character class given as sorted ranges
+func classExample(ranges []rune) (rune, bool) {
+	if len(ranges) == 0 {
+		return 0, false
+	}
+	for _, preferred := range []rune{'a', '0', 'A', '_'} {
+		for i := 0; i+1 < len(ranges); i += 2 {
+			if ranges[i] <= preferred && preferred <= ranges[i+1] {
+				return preferred, true
+			}
+		}
+	}
+	return ranges[0], true
+}
@@ -0,0 +1,156 @@
+package schema
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestGenerateExampleNestedRequired(t *testing.T) {
+	s := Schema{Content: mustSchema(t, `{
+		"type": "object",
+		"required": ["id", "status", "owner", "tags"],
+		"properties": {
+			"id": {"type": "string", "minLength": 10},
+			"status": {"type": "string", "enum": ["draft", "active"]},
+			"priority": {"type": "integer", "default": 3},
+			"notes": {"type": "string"},
+			"owner": {
+				"type": "object",
+				"required": ["name", "contact"],
+				"properties": {
+					"name": {"type": "string"},
+					"contact": {"$ref": "#/definitions/contact"}
+				}
+			},
+			"tags": {"type": "array", "items": {"type": "string", "default": "general"}}
+		},
+		"definitions": {
+			"contact": {
+				"type": "object",
+				"required": ["email"],
+				"properties": {"email": {"type": "string", "format": "email"}}
+			}
+		}
+	}`)}
+
+	data, err := GenerateExample(s)
+	if err != nil {
+		t.Fatalf("GenerateExample() error = %v", err)
+	}
+
+	var got map[string]interface{}
+	if err := json.Unmarshal(data, &got); err != nil {
+		t.Fatalf("Example is not valid JSON: %v", err)
+	}
+
+	if got["status"] != "draft" {
+		t.Errorf("status = %v, want first enum value draft", got["status"])
+	}
+	if _, ok := got["notes"]; ok {
+		t.Error("Optional properties should be omitted")
+	}
+	owner, _ := got["owner"].(map[string]interface{})
+	contact, _ := owner["contact"].(map[string]interface{})
+	if contact["email"] != "user@example.com" {
+		t.Errorf("owner.contact.email = %v, want resolved reference", contact["email"])
+	}
+	if tags, _ := got["tags"].([]interface{}); len(tags) != 1 || tags[0] != "general" {
+		t.Errorf("tags = %v, want single default element", got["tags"])
+	}
+
+	schemaData, _ := json.Marshal(s.Content)
+	result, err := ValidateJSON(schemaData, data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !result.Valid {
+		t.Errorf("Example does not validate against its schema: %v\n%s", result.Errors, data)
+	}
+}
+
+func TestGenerateExampleRecursiveReference(t *testing.T) {
+	s := Schema{Content: mustSchema(t, `{
+		"$ref": "#/definitions/node",
+		"definitions": {
+			"node": {"type": "object", "required": ["child"], "properties": {"child": {"$ref": "#/definitions/node"}}}
+		}
+	}`)}
+
+	if _, err := GenerateExample(s); err == nil {
+		t.Error("Expected an error for a required recursive reference")
+	}
+}
+
+func TestGenerateExampleShippedSchemas(t *testing.T) {
+	registry := NewRegistry(filepath.Join("..", "..", "schemas"))
+	if err := registry.Discover(); err != nil {
+		t.Fatal(err)
+	}
+
+	schemas := registry.List()
+	if len(schemas) == 0 {
+		t.Fatal("No schemas found")
+	}
+	for _, s := range schemas {
+		t.Run(s.Name, func(t *testing.T) {
+			data, err := GenerateExample(*s)
+			if err != nil {
+				t.Fatalf("GenerateExample() error = %v", err)
+			}
+
+			// The example must also pass the check 'workflows validate' runs
+			path := filepath.Join(t.TempDir(), "example.json")
+			if err := os.WriteFile(path, data, 0644); err != nil {
+				t.Fatal(err)
+			}
+			result, err := ValidateFile(s.Path, path)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !result.Valid {
+				t.Errorf("Example does not validate: %v\n%s", result.Errors, data)
+			}
+		})
+	}
+}
+
+func TestGenerateExamplePatterns(t *testing.T) {
+	s := Schema{Content: mustSchema(t, `{
+		"type": "object",
+		"required": ["id", "username", "version"],
+		"properties": {
+			"id": {"type": "string", "pattern": "^ADR-[0-9]{4}$"},
+			"username": {"type": "string", "pattern": "^[a-zA-Z0-9_]+$", "minLength": 3},
+			"version": {"type": "string", "pattern": "^[0-9]+\\.[0-9]+$"}
+		}
+	}`)}
+
+	data, err := GenerateExample(s)
+	if err != nil {
+		t.Fatalf("GenerateExample() error = %v", err)
+	}
+	var got map[string]interface{}
+	if err := json.Unmarshal(data, &got); err != nil {
+		t.Fatal(err)
+	}
+	for field, want := range map[string]string{"id": "ADR-0000", "username": "aaa", "version": "0.0"} {
+		if got[field] != want {
+			t.Errorf("%s = %v, want %s", field, got[field], want)
+		}
+	}
+}
+
+func TestGenerateExampleInvalid(t *testing.T) {
+	// Word boundaries are not generated, so the placeholder fails the pattern
+	s := Schema{Content: mustSchema(t, `{
+		"type": "object",
+		"required": ["code"],
+		"properties": {"code": {"type": "string", "pattern": "^\\bX"}}
+	}`)}
+
+	if _, err := GenerateExample(s); err == nil {
+		t.Error("Expected an error for an example that does not validate")
+	}
+}
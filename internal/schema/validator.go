@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"path/filepath"
 
 	"github.com/xeipuuv/gojsonschema"
 )
@@ -15,29 +16,63 @@ type ValidationResult struct {
 }
 
 func ValidateFile(schemaPath, filePath string) (*ValidationResult, error) {
-	schemaFile, err := os.Open(schemaPath)
+	dataFile, err := os.Open(filePath)
 	if err != nil {
-		return nil, fmt.Errorf("failed to open schema file: %w", err)
+		return nil, fmt.Errorf("failed to open data file: %w", err)
 	}
-	defer schemaFile.Close()
+	defer dataFile.Close()
 
-	schemaData, err := io.ReadAll(schemaFile)
+	data, err := io.ReadAll(dataFile)
 	if err != nil {
-		return nil, fmt.Errorf("failed to read schema file: %w", err)
+		return nil, fmt.Errorf("failed to read data file: %w", err)
 	}
 
-	dataFile, err := os.Open(filePath)
+	return ValidateDocument(schemaPath, data)
+}
+
+// ValidateDocument validates JSON data against the schema file at
+// schemaPath. References to sibling schema files resolve from its directory,
+// including siblings referenced through their $id.
+func ValidateDocument(schemaPath string, data []byte) (*ValidationResult, error) {
+	if _, err := os.Stat(schemaPath); err != nil {
+		return nil, fmt.Errorf("failed to open schema file: %w", err)
+	}
+	absPath, err := filepath.Abs(schemaPath)
 	if err != nil {
-		return nil, fmt.Errorf("failed to open data file: %w", err)
+		return nil, fmt.Errorf("failed to resolve schema path: %w", err)
 	}
-	defer dataFile.Close()
 
-	data, err := io.ReadAll(dataFile)
+	loader := gojsonschema.NewSchemaLoader()
+	siblings, err := filepath.Glob(filepath.Join(filepath.Dir(absPath), "*.json"))
 	if err != nil {
-		return nil, fmt.Errorf("failed to read data file: %w", err)
+		return nil, fmt.Errorf("failed to list sibling schemas: %w", err)
+	}
+	for _, path := range siblings {
+		if path == absPath {
+			continue
+		}
+		sibling, err := LoadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load sibling schema %s: %w", path, err)
+		}
+		// Siblings without an $id are found by their file URL
+		if _, ok := sibling.Content["$id"].(string); !ok {
+			continue
+		}
+		if err := loader.AddSchemas(gojsonschema.NewGoLoader(sibling.Content)); err != nil {
+			return nil, fmt.Errorf("failed to load sibling schema %s: %w", path, err)
+		}
 	}
 
-	return ValidateJSON(schemaData, data)
+	compiled, err := loader.Compile(gojsonschema.NewReferenceLoader("file://" + filepath.ToSlash(absPath)))
+	if err != nil {
+		return nil, fmt.Errorf("validation failed: %w", err)
+	}
+	result, err := compiled.Validate(gojsonschema.NewBytesLoader(data))
+	if err != nil {
+		return nil, fmt.Errorf("validation failed: %w", err)
+	}
+	return newValidationResult(result), nil
 }
 
 func ValidateJSON(schemaData, data []byte) (*ValidationResult, error) {
@@ -49,6 +84,10 @@ func ValidateJSON(schemaData, data []byte) (*ValidationResult, error) {
 		return nil, fmt.Errorf("validation failed: %w", err)
 	}
 
+	return newValidationResult(result), nil
+}
+
+func newValidationResult(result *gojsonschema.Result) *ValidationResult {
 	vr := &ValidationResult{
 		Valid:  result.Valid(),
 		Errors: make([]string, 0),
@@ -60,7 +99,7 @@ func ValidateJSON(schemaData, data []byte) (*ValidationResult, error) {
 		}
 	}
 
-	return vr, nil
+	return vr
 }
 
 func ValidateObject(schema *Schema, data interface{}) (*ValidationResult, error) {
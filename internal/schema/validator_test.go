@@ -0,0 +1,95 @@
+package schema
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func writeSchemaFiles(t *testing.T, files map[string]string) string {
+	t.Helper()
+	dir := t.TempDir()
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return dir
+}
+
+func TestValidateFileSiblingReferenceByID(t *testing.T) {
+	// Both schemas declare a remote $id; the reference must resolve to the
+	// sibling file rather than be fetched
+	dir := writeSchemaFiles(t, map[string]string{
+		"order.json": `{
+			"$id": "https://schemas.invalid/order.json",
+			"type": "object",
+			"required": ["id"],
+			"properties": {"id": {"$ref": "common.json#/definitions/id"}}
+		}`,
+		"common.json": `{
+			"$id": "https://schemas.invalid/common.json",
+			"definitions": {"id": {"type": "string", "pattern": "^ORD-[0-9]+$"}}
+		}`,
+		"valid.json":   `{"id": "ORD-1"}`,
+		"invalid.json": `{"id": "order-1"}`,
+	})
+
+	result, err := ValidateFile(filepath.Join(dir, "order.json"), filepath.Join(dir, "valid.json"))
+	if err != nil {
+		t.Fatalf("ValidateFile() error = %v", err)
+	}
+	if !result.Valid {
+		t.Errorf("Expected valid document, got errors %v", result.Errors)
+	}
+
+	result, err = ValidateFile(filepath.Join(dir, "order.json"), filepath.Join(dir, "invalid.json"))
+	if err != nil {
+		t.Fatalf("ValidateFile() error = %v", err)
+	}
+	if result.Valid || len(result.Errors) != 1 || !strings.HasPrefix(result.Errors[0], "id:") {
+		t.Errorf("Expected a pattern error on id, got valid=%v errors=%v", result.Valid, result.Errors)
+	}
+}
+
+func TestValidateFileSiblingReferenceByPath(t *testing.T) {
+	dir := writeSchemaFiles(t, map[string]string{
+		"order.json": `{
+			"type": "object",
+			"required": ["total"],
+			"properties": {"total": {"$ref": "common.json#/definitions/amount"}}
+		}`,
+		"common.json":    `{"definitions": {"amount": {"type": "number", "minimum": 0}}}`,
+		"order-doc.json": `{"total": -1}`,
+	})
+
+	result, err := ValidateFile(filepath.Join(dir, "order.json"), filepath.Join(dir, "order-doc.json"))
+	if err != nil {
+		t.Fatalf("ValidateFile() error = %v", err)
+	}
+	if result.Valid {
+		t.Error("Expected the referenced minimum to be enforced")
+	}
+}
+
+func TestValidateFileMissingSchema(t *testing.T) {
+	dir := writeSchemaFiles(t, map[string]string{"doc.json": `{}`})
+	if _, err := ValidateFile(filepath.Join(dir, "missing.json"), filepath.Join(dir, "doc.json")); err == nil {
+		t.Error("Expected an error for a missing schema file")
+	}
+}
+
+func TestShippedSchemasCompile(t *testing.T) {
+	// Every shipped schema must load, with its references resolved and its
+	// patterns accepted by Go's regexp engine
+	registry := NewRegistry(filepath.Join("..", "..", "schemas"))
+	if err := registry.Discover(); err != nil {
+		t.Fatal(err)
+	}
+	for _, s := range registry.List() {
+		if _, err := ValidateDocument(s.Path, []byte(`{}`)); err != nil {
+			t.Errorf("%s: %v", s.Name, err)
+		}
+	}
+}